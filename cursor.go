@@ -6,7 +6,7 @@ import (
 	"fmt"
 )
 
-// CursorDirection indicates forward or backward traversal.
+// CursorDirection indicates forward or backward traversal, or a refresh from the head.
 type CursorDirection string
 
 const (
@@ -14,6 +14,10 @@ const (
 	Next CursorDirection = "next"
 	// Prev indicates backward pagination (items before the cursor).
 	Prev CursorDirection = "prev"
+	// Head indicates a refresh from the top of a feed: items before the cursor
+	// (newer, in a newest-first feed), returned in reverse sort order, i.e.
+	// chronologically, starting with the item nearest the cursor.
+	Head CursorDirection = "head"
 )
 
 // CursorData holds the raw values encoded inside a cursor token.
@@ -25,6 +29,12 @@ type CursorData struct {
 	// Extra holds additional cursor fields for compound cursors
 	// (e.g., created_at + id for stable ordering).
 	Extra map[string]string `json:"e"`
+	// Keys holds typed, ordered cursor values for keyset pagination
+	// (e.g., created_at then id). Prefer it over Value and Extra for new code.
+	Keys CursorKeys `json:"k,omitempty"`
+	// Page is the page number the cursor leads to, used by HybridRequest.
+	// Zero for plain cursor pagination.
+	Page int `json:"p,omitempty"`
 }
 
 // EncodeCursor encodes a CursorData struct into a base64 URL-safe cursor token.
@@ -0,0 +1,160 @@
+package pageable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// CursorFromItem builds a Next CursorData from the boundary row of a page,
+// holding one typed key per sort field in sort order.
+// item must be a struct or a pointer to a struct. Each sort field is matched
+// against the struct's `db` tag, then its `json` tag, then its field name
+// (case-insensitively), so sorts renamed by MapSortFields to column names still match.
+// A table qualifier such as "posts." is ignored when matching.
+// Nil pointers become null keys, and driver.Valuer types (e.g., sql.NullTime) are unwrapped.
+// Set Direction to Prev on the result when building a cursor from the first item.
+func CursorFromItem(item any, sorts []Sort) (CursorData, error) {
+	values, err := itemValues(item, sorts)
+	if err != nil {
+		return CursorData{}, err
+	}
+	keys := make(CursorKeys, len(sorts))
+	for i, s := range sorts {
+		keys[i] = CursorKey{Field: s.Field, Value: values[i]}
+	}
+	return CursorData{Direction: Next, Keys: keys}, nil
+}
+
+// itemValues returns the cursor values of item for each sort field, in order.
+func itemValues(item any, sorts []Sort) ([]any, error) {
+	values := make([]any, len(sorts))
+	for i, s := range sorts {
+		f, err := sortField(item, s)
+		if err != nil {
+			return nil, err
+		}
+		v, err := cursorValue(f)
+		if err != nil {
+			return nil, fmt.Errorf("pageable: field %q: %w", s.Field, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// sortField returns the value of item that s orders by: the struct field
+// matching the sort field, or the nested value of a JSON path sort.
+func sortField(item any, s Sort) (reflect.Value, error) {
+	if s.JSONPath != nil {
+		return s.JSONPath.lookup(item)
+	}
+	return itemField(item, s.Field)
+}
+
+// itemField returns the value of the struct field matching name.
+func itemField(item any, name string) (reflect.Value, error) {
+	v := reflect.ValueOf(item)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, fmt.Errorf("pageable: item is a nil %s", v.Type())
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("pageable: item must be a struct, got %s", v.Type())
+	}
+
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	if f, ok := findField(v, name); ok {
+		return f, nil
+	}
+	return reflect.Value{}, fmt.Errorf("pageable: no field for %q in %s", name, v.Type())
+}
+
+// findField looks up a field by db tag, json tag, then name, descending into
+// embedded structs.
+func findField(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for _, tag := range []string{"db", "json", ""} {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			if fieldMatches(sf, tag, name) {
+				return v.Field(i), true
+			}
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.Anonymous || sf.Type.Kind() != reflect.Struct {
+			continue
+		}
+		if f, ok := findField(v.Field(i), name); ok {
+			return f, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// fieldMatches reports whether sf is named name by the given tag, or by its
+// Go name when tag is empty.
+func fieldMatches(sf reflect.StructField, tag, name string) bool {
+	if tag == "" {
+		return strings.EqualFold(sf.Name, name)
+	}
+	tagName, _, _ := strings.Cut(sf.Tag.Get(tag), ",")
+	return tagName != "" && tagName != "-" && tagName == name
+}
+
+// cursorValue converts a struct field to a value CursorKey can encode.
+// An invalid Value (a missing JSON member) is null.
+func cursorValue(v reflect.Value) (any, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if !v.CanInterface() {
+		return nil, fmt.Errorf("unexported value of type %s", v.Type())
+	}
+
+	iface := v.Interface()
+	switch x := iface.(type) {
+	case time.Time:
+		return x, nil
+	case driver.Valuer:
+		dv, err := x.Value()
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := dv.([]byte); ok {
+			return string(b), nil
+		}
+		return dv, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
@@ -0,0 +1,98 @@
+package pageable
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+type cursorTestBase struct {
+	ID int64 `db:"id"`
+}
+
+type cursorTestRow struct {
+	cursorTestBase
+	CreatedAt time.Time    `db:"created_at" json:"createdAt"`
+	Title     string       `json:"title"`
+	Score     float64      `json:"-"`
+	DeletedAt *time.Time   `db:"deleted_at"`
+	Archived  sql.NullBool `db:"archived"`
+	Views     uint32
+}
+
+func TestCursorFromItem(t *testing.T) {
+	ts := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	row := cursorTestRow{
+		cursorTestBase: cursorTestBase{ID: 42},
+		CreatedAt:      ts,
+		Title:          "Hello",
+		Score:          1.5,
+		Archived:       sql.NullBool{Bool: true, Valid: true},
+		Views:          7,
+	}
+	sorts := []Sort{
+		{Field: "posts.created_at", Direction: DESC},
+		{Field: "title", Direction: ASC},
+		{Field: "score", Direction: ASC},
+		{Field: "deleted_at", Direction: ASC},
+		{Field: "archived", Direction: ASC},
+		{Field: "views", Direction: ASC},
+		{Field: "id", Direction: ASC},
+	}
+
+	data, err := CursorFromItem(&row, sorts)
+	if err != nil {
+		t.Fatalf("CursorFromItem error: %v", err)
+	}
+	if data.Direction != Next {
+		t.Errorf("Direction = %q, want %q", data.Direction, Next)
+	}
+	if len(data.Keys) != len(sorts) {
+		t.Fatalf("Keys length = %d, want %d", len(data.Keys), len(sorts))
+	}
+	for i, s := range sorts {
+		if data.Keys[i].Field != s.Field {
+			t.Errorf("Keys[%d].Field = %q, want %q", i, data.Keys[i].Field, s.Field)
+		}
+	}
+
+	if got, _ := data.Keys.Time("posts.created_at"); !got.Equal(ts) {
+		t.Errorf("created_at = %v, want %v", got, ts)
+	}
+	if got, _ := data.Keys.String("title"); got != "Hello" {
+		t.Errorf("title = %q, want %q", got, "Hello")
+	}
+	if got, _ := data.Keys.Float64("score"); got != 1.5 {
+		t.Errorf("score = %v, want 1.5", got)
+	}
+	if null, _ := data.Keys.IsNull("deleted_at"); !null {
+		t.Error("deleted_at should be null")
+	}
+	if got, _ := data.Keys.Bool("archived"); !got {
+		t.Error("archived should be true")
+	}
+	if got, _ := data.Keys.Int64("views"); got != 7 {
+		t.Errorf("views = %d, want 7", got)
+	}
+	if got, _ := data.Keys.Int64("id"); got != 42 {
+		t.Errorf("id = %d, want 42", got)
+	}
+
+	if _, err := EncodeCursor(data); err != nil {
+		t.Errorf("EncodeCursor error: %v", err)
+	}
+}
+
+func TestCursorFromItemErrors(t *testing.T) {
+	sorts := []Sort{{Field: "missing", Direction: ASC}}
+	if _, err := CursorFromItem(cursorTestRow{}, sorts); err == nil {
+		t.Error("expected error for unknown field")
+	}
+	if _, err := CursorFromItem(42, sorts); err == nil {
+		t.Error("expected error for non-struct item")
+	}
+	var nilRow *cursorTestRow
+	if _, err := CursorFromItem(nilRow, sorts); err == nil {
+		t.Error("expected error for nil item")
+	}
+}
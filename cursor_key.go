@@ -0,0 +1,264 @@
+package pageable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrCursorKeyNotFound is returned by CursorKeys accessors when the field is absent.
+	ErrCursorKeyNotFound = errors.New("pageable: cursor key not found")
+	// ErrCursorKeyType is returned by CursorKeys accessors when the value has a different type.
+	ErrCursorKeyType = errors.New("pageable: cursor key type mismatch")
+)
+
+// Type tags used in the JSON encoding of a CursorKey.
+const (
+	keyTypeString = "s"
+	keyTypeInt    = "i"
+	keyTypeFloat  = "f"
+	keyTypeTime   = "t"
+	keyTypeBool   = "b"
+	keyTypeNull   = "n"
+)
+
+// CursorKey is a single named, typed value in a cursor.
+// Value may be a string, any integer type, float32/float64, time.Time, bool, or nil.
+// After decoding, integers are int64, floats are float64 and times are time.Time.
+type CursorKey struct {
+	Field string
+	Value any
+}
+
+// cursorKeyJSON is the wire format of a CursorKey. All values are encoded as
+// strings so int64 and float64 round-trip without precision loss.
+type cursorKeyJSON struct {
+	Field string `json:"f"`
+	Type  string `json:"t"`
+	Value string `json:"v,omitempty"`
+}
+
+// MarshalJSON encodes the key with a type tag so it decodes to the same Go type.
+// Returns an error for unsupported value types.
+func (k CursorKey) MarshalJSON() ([]byte, error) {
+	out := cursorKeyJSON{Field: k.Field}
+	switch v := k.Value.(type) {
+	case nil:
+		out.Type = keyTypeNull
+	case string:
+		out.Type, out.Value = keyTypeString, v
+	case bool:
+		out.Type, out.Value = keyTypeBool, strconv.FormatBool(v)
+	case float32:
+		out.Type, out.Value = keyTypeFloat, strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		out.Type, out.Value = keyTypeFloat, strconv.FormatFloat(v, 'g', -1, 64)
+	case time.Time:
+		out.Type, out.Value = keyTypeTime, v.Format(time.RFC3339Nano)
+	default:
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, fmt.Errorf("pageable: unsupported cursor value type %T for %q", k.Value, k.Field)
+		}
+		out.Type, out.Value = keyTypeInt, strconv.FormatInt(i, 10)
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a key produced by MarshalJSON.
+func (k *CursorKey) UnmarshalJSON(b []byte) error {
+	var in cursorKeyJSON
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+
+	var (
+		value any
+		err   error
+	)
+	switch in.Type {
+	case keyTypeNull:
+		value = nil
+	case keyTypeString:
+		value = in.Value
+	case keyTypeBool:
+		value, err = strconv.ParseBool(in.Value)
+	case keyTypeFloat:
+		value, err = strconv.ParseFloat(in.Value, 64)
+	case keyTypeTime:
+		value, err = time.Parse(time.RFC3339Nano, in.Value)
+	case keyTypeInt:
+		value, err = strconv.ParseInt(in.Value, 10, 64)
+	default:
+		return fmt.Errorf("pageable: unknown cursor value type %q for %q", in.Type, in.Field)
+	}
+	if err != nil {
+		return fmt.Errorf("pageable: invalid cursor value for %q: %w", in.Field, err)
+	}
+
+	*k = CursorKey{Field: in.Field, Value: value}
+	return nil
+}
+
+// CursorKeys is an ordered list of typed cursor values, one per sort column.
+// Unlike CursorData.Extra, it keeps column order for compound keys and
+// round-trips int64, float64, time.Time, bool, null and string losslessly.
+type CursorKeys []CursorKey
+
+// Get returns the value for field and whether it is present.
+func (ks CursorKeys) Get(field string) (any, bool) {
+	for _, k := range ks {
+		if k.Field == field {
+			return k.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Fields returns the field names in order.
+func (ks CursorKeys) Fields() []string {
+	fields := make([]string, len(ks))
+	for i, k := range ks {
+		fields[i] = k.Field
+	}
+	return fields
+}
+
+// Values returns the values in order, suitable as bind arguments.
+func (ks CursorKeys) Values() []any {
+	values := make([]any, len(ks))
+	for i, k := range ks {
+		values[i] = k.Value
+	}
+	return values
+}
+
+// IsNull reports whether the value for field is null.
+func (ks CursorKeys) IsNull(field string) (bool, error) {
+	v, ok := ks.Get(field)
+	if !ok {
+		return false, fmt.Errorf("%w: %q", ErrCursorKeyNotFound, field)
+	}
+	return v == nil, nil
+}
+
+// String returns the value for field as a string.
+func (ks CursorKeys) String(field string) (string, error) {
+	v, err := ks.lookup(field)
+	if err != nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", typeMismatch(field, v, "string")
+	}
+	return s, nil
+}
+
+// Int64 returns the value for field as an int64.
+func (ks CursorKeys) Int64(field string) (int64, error) {
+	v, err := ks.lookup(field)
+	if err != nil {
+		return 0, err
+	}
+	i, ok := toInt64(v)
+	if !ok {
+		return 0, typeMismatch(field, v, "int64")
+	}
+	return i, nil
+}
+
+// Float64 returns the value for field as a float64.
+func (ks CursorKeys) Float64(field string) (float64, error) {
+	v, err := ks.lookup(field)
+	if err != nil {
+		return 0, err
+	}
+	switch f := v.(type) {
+	case float64:
+		return f, nil
+	case float32:
+		return float64(f), nil
+	}
+	return 0, typeMismatch(field, v, "float64")
+}
+
+// Time returns the value for field as a time.Time.
+func (ks CursorKeys) Time(field string) (time.Time, error) {
+	v, err := ks.lookup(field)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, typeMismatch(field, v, "time")
+	}
+	return t, nil
+}
+
+// Bool returns the value for field as a bool.
+func (ks CursorKeys) Bool(field string) (bool, error) {
+	v, err := ks.lookup(field)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, typeMismatch(field, v, "bool")
+	}
+	return b, nil
+}
+
+// lookup returns the value for field, or ErrCursorKeyNotFound.
+func (ks CursorKeys) lookup(field string) (any, error) {
+	v, ok := ks.Get(field)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrCursorKeyNotFound, field)
+	}
+	return v, nil
+}
+
+// typeMismatch builds an ErrCursorKeyType error describing the actual and wanted types.
+func typeMismatch(field string, v any, want string) error {
+	return fmt.Errorf("%w: %q is %T, not %s", ErrCursorKeyType, field, v, want)
+}
+
+// toInt64 converts any Go integer type to int64.
+// Returns false for other types and for uint values that overflow int64.
+func toInt64(v any) (int64, bool) {
+	switch i := v.(type) {
+	case int:
+		return int64(i), true
+	case int8:
+		return int64(i), true
+	case int16:
+		return int64(i), true
+	case int32:
+		return int64(i), true
+	case int64:
+		return i, true
+	case uint:
+		return uintToInt64(uint64(i))
+	case uint8:
+		return int64(i), true
+	case uint16:
+		return int64(i), true
+	case uint32:
+		return int64(i), true
+	case uint64:
+		return uintToInt64(i)
+	}
+	return 0, false
+}
+
+// uintToInt64 converts u to int64, reporting false on overflow.
+func uintToInt64(u uint64) (int64, bool) {
+	if u > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(u), true
+}
@@ -0,0 +1,118 @@
+package pageable
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCursorKeysRoundTrip(t *testing.T) {
+	ts := time.Date(2024, 1, 15, 10, 30, 0, 123456789, time.FixedZone("X", 3600))
+	original := CursorData{
+		Direction: Next,
+		Keys: CursorKeys{
+			{Field: "created_at", Value: ts},
+			{Field: "score", Value: 0.1},
+			{Field: "big", Value: int64(math.MaxInt64)},
+			{Field: "small", Value: 7},
+			{Field: "active", Value: true},
+			{Field: "deleted_at", Value: nil},
+			{Field: "name", Value: "alice"},
+			{Field: "ratio", Value: math.Inf(1)},
+		},
+	}
+
+	encoded, err := EncodeCursor(original)
+	if err != nil {
+		t.Fatalf("EncodeCursor error: %v", err)
+	}
+	decoded, err := DecodeCursor(encoded)
+	if err != nil {
+		t.Fatalf("DecodeCursor error: %v", err)
+	}
+
+	wantFields := []string{"created_at", "score", "big", "small", "active", "deleted_at", "name", "ratio"}
+	gotFields := decoded.Keys.Fields()
+	if len(gotFields) != len(wantFields) {
+		t.Fatalf("Fields = %v, want %v", gotFields, wantFields)
+	}
+	for i := range wantFields {
+		if gotFields[i] != wantFields[i] {
+			t.Errorf("Fields[%d] = %q, want %q", i, gotFields[i], wantFields[i])
+		}
+	}
+
+	if got, _ := decoded.Keys.Time("created_at"); !got.Equal(ts) {
+		t.Errorf("created_at = %v, want %v", got, ts)
+	}
+	if got, _ := decoded.Keys.Float64("score"); got != 0.1 {
+		t.Errorf("score = %v, want 0.1", got)
+	}
+	if got, _ := decoded.Keys.Int64("big"); got != math.MaxInt64 {
+		t.Errorf("big = %d, want %d", got, int64(math.MaxInt64))
+	}
+	if got, _ := decoded.Keys.Int64("small"); got != 7 {
+		t.Errorf("small = %d, want 7", got)
+	}
+	if got, _ := decoded.Keys.Bool("active"); !got {
+		t.Error("active should be true")
+	}
+	if null, _ := decoded.Keys.IsNull("deleted_at"); !null {
+		t.Error("deleted_at should be null")
+	}
+	if got, _ := decoded.Keys.String("name"); got != "alice" {
+		t.Errorf("name = %q, want %q", got, "alice")
+	}
+	if got, _ := decoded.Keys.Float64("ratio"); !math.IsInf(got, 1) {
+		t.Errorf("ratio = %v, want +Inf", got)
+	}
+}
+
+func TestCursorKeysAccessorErrors(t *testing.T) {
+	keys := CursorKeys{{Field: "id", Value: int64(42)}, {Field: "name", Value: "alice"}}
+
+	if _, err := keys.Int64("missing"); !errors.Is(err, ErrCursorKeyNotFound) {
+		t.Errorf("Int64(missing) error = %v, want ErrCursorKeyNotFound", err)
+	}
+	if _, err := keys.Time("id"); !errors.Is(err, ErrCursorKeyType) {
+		t.Errorf("Time(id) error = %v, want ErrCursorKeyType", err)
+	}
+	if _, err := keys.Int64("name"); !errors.Is(err, ErrCursorKeyType) {
+		t.Errorf("Int64(name) error = %v, want ErrCursorKeyType", err)
+	}
+	if _, err := keys.IsNull("missing"); !errors.Is(err, ErrCursorKeyNotFound) {
+		t.Errorf("IsNull(missing) error = %v, want ErrCursorKeyNotFound", err)
+	}
+}
+
+func TestCursorKeyUnsupportedType(t *testing.T) {
+	_, err := EncodeCursor(CursorData{Keys: CursorKeys{{Field: "x", Value: []int{1}}}})
+	if err == nil {
+		t.Error("expected error for unsupported value type")
+	}
+
+	_, err = EncodeCursor(CursorData{Keys: CursorKeys{{Field: "x", Value: uint64(math.MaxUint64)}}})
+	if err == nil {
+		t.Error("expected error for uint64 overflow")
+	}
+}
+
+func TestCursorKeyUnknownType(t *testing.T) {
+	var k CursorKey
+	if err := json.Unmarshal([]byte(`{"f":"id","t":"x","v":"1"}`), &k); err == nil {
+		t.Error("expected error for unknown type tag")
+	}
+	if err := json.Unmarshal([]byte(`{"f":"id","t":"i","v":"abc"}`), &k); err == nil {
+		t.Error("expected error for invalid int value")
+	}
+}
+
+func TestCursorKeysValues(t *testing.T) {
+	keys := CursorKeys{{Field: "a", Value: 1}, {Field: "b", Value: "x"}}
+	values := keys.Values()
+	if len(values) != 2 || values[0] != 1 || values[1] != "x" {
+		t.Errorf("Values = %v, want [1 x]", values)
+	}
+}
@@ -9,12 +9,23 @@ type CursorPageMetadata struct {
 	HasNext    bool   `json:"hasNext"`
 	HasPrev    bool   `json:"hasPrev"`
 	Size       int    `json:"size"`
+	// HeadCursor resumes at the top of the feed (see HeadCursor), for
+	// pull-to-refresh. Set with WithHeadCursor; omitted when empty.
+	HeadCursor string `json:"headCursor,omitempty"`
+	// HasNewer reports, on a page fetched with a Head cursor, that more newer
+	// items remain than fit the page; refresh again with HeadCursor to get them.
+	HasNewer bool `json:"hasNewer,omitempty"`
+	// NextSince is the timestamp of the newest item seen by a time-window feed
+	// (RFC 3339), set by NewTimeWindowPage. Omitted for other cursor pages.
+	NextSince string `json:"nextSince,omitempty"`
 }
 
 // CursorPage represents a paginated response for cursor-based pagination.
 type CursorPage[T any] struct {
 	Items    []T                `json:"items"`
 	Metadata CursorPageMetadata `json:"metadata"`
+
+	render pageRender
 }
 
 // EmptyCursorPage creates an empty CursorPage with no items and no cursors.
@@ -41,3 +52,56 @@ func NewCursorPage[T any](items []T, nextCursor, prevCursor string, hasNext, has
 		},
 	}
 }
+
+// WithHeadCursor returns the page with its head cursor set (see HeadCursor).
+func (p CursorPage[T]) WithHeadCursor(cursor string) CursorPage[T] {
+	p.Metadata.HeadCursor = cursor
+	return p
+}
+
+// HeadCursor returns the Head cursor for a page of items fetched for req, to be
+// returned with every page, whether or not it has a previous page. Requesting it
+// yields the items newer than the page's top item (see Head); such a page
+// returns a fresh head cursor from its newest item, with HasNext false and
+// HasNewer set when more newer items remain. On an empty page, a Head
+// request keeps its cursor and any other request has no head cursor.
+// Items must be in the order they were fetched in, after any Keyset.Reverse.
+func HeadCursor[T any](items []T, req CursorRequest) (string, error) {
+	data, err := req.DecodedCursor()
+	if err != nil {
+		return "", err
+	}
+	head := data.Direction == Head
+	if len(items) == 0 {
+		if head {
+			return req.Cursor, nil
+		}
+		return "", nil
+	}
+	item := items[0]
+	if head {
+		item = items[len(items)-1]
+	}
+	return sliceCursor(item, req.Sort, Head)
+}
+
+// SelectFields returns the page with items marshaled to JSON with only the
+// given top-level fields (e.g., the request's Fields), named as in the items'
+// JSON encoding. The envelope is unchanged. No fields marshals items whole.
+func (p CursorPage[T]) SelectFields(fields ...string) CursorPage[T] {
+	p.render.fields = fields
+	return p
+}
+
+// WithEnvelope returns the page marshaled to JSON with the key names and
+// shape of e instead of the default "items"/"metadata" envelope.
+func (p CursorPage[T]) WithEnvelope(e Envelope) CursorPage[T] {
+	p.render.envelope = &e
+	return p
+}
+
+// MarshalJSON implements json.Marshaler, applying the fields set by
+// SelectFields and the envelope set by WithEnvelope.
+func (p CursorPage[T]) MarshalJSON() ([]byte, error) {
+	return marshalPage(p.Items, p.Metadata, p.render)
+}
@@ -3,14 +3,17 @@ package pageable
 import (
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 // CursorRequest represents cursor-based pagination parameters.
 type CursorRequest struct {
-	Cursor string
-	Size   int
-	Sort   []Sort
+	Cursor  string
+	Size    int
+	Sort    []Sort
+	Filters []Filter
+	Fields  []string
+
+	requestState
 }
 
 // NewCursorRequest creates a CursorRequest with defaults applied.
@@ -26,13 +29,15 @@ func NewCursorRequest(cursor string, size int, sort []Sort) CursorRequest {
 }
 
 // CursorRequestFromQuery parses a CursorRequest from URL query parameters.
-// Recognized keys: "cursor", "size", "sort".
-// Defaults: empty cursor (first page), DefaultCursorSize.
-func CursorRequestFromQuery(values url.Values) CursorRequest {
-	cursor := values.Get("cursor")
+// Recognized keys: "cursor", "size", "sort", "fields"; all other keys are parsed as
+// filters (see ParseFilters), applied once allowed by FilterableFields.
+// Defaults: empty cursor (first page), DefaultCursorSize. Options change how sorts are read.
+func CursorRequestFromQuery(values url.Values, opts ...QueryOption) CursorRequest {
+	query := newQueryConfig(opts)
+	cursor := values.Get(paramCursor)
 
 	size := DefaultCursorSize
-	if v := values.Get("size"); v != "" {
+	if v := values.Get(paramSize); v != "" {
 		if s, err := strconv.Atoi(v); err == nil && s > 0 {
 			size = s
 		}
@@ -41,12 +46,12 @@ func CursorRequestFromQuery(values url.Values) CursorRequest {
 		size = MaxCursorSize
 	}
 
-	var sort []Sort
-	if sortParams := values["sort"]; len(sortParams) > 0 {
-		sort = ParseSorts(sortParams)
+	return CursorRequest{
+		Cursor:       cursor,
+		Size:         size,
+		Sort:         query.parseSorts(values),
+		requestState: newRequestState(values, query),
 	}
-
-	return CursorRequest{Cursor: cursor, Size: size, Sort: sort}
 }
 
 // SortableFields filters sorts to only include the specified fields.
@@ -56,11 +61,27 @@ func (cr CursorRequest) SortableFields(fields ...string) CursorRequest {
 	return cr
 }
 
+// ApplySortPolicy works like PageRequest.ApplySortPolicy.
+func (cr CursorRequest) ApplySortPolicy(policy SortPolicy) (CursorRequest, error) {
+	sorts, err := policy.Apply(cr.Sort)
+	if err != nil {
+		return cr, err
+	}
+	cr.Sort = sorts
+	return cr, nil
+}
+
 // MapSortFields replaces sort field names using the provided mapping.
 // Use this to translate user-facing field names (e.g., "createdAt") to
 // database column names (e.g., "created_at"). Unmapped fields are kept as-is.
 func (cr CursorRequest) MapSortFields(fieldMap map[string]string) CursorRequest {
-	cr.Sort = mapSortFields(cr.Sort, fieldMap)
+	cr.Sort = cr.mapSortFields(cr.Sort, fieldMap)
+	return cr
+}
+
+// MapJSONSortFields works like PageRequest.MapJSONSortFields.
+func (cr CursorRequest) MapJSONSortFields(fields JSONSortFields) CursorRequest {
+	cr.Sort = cr.mapJSONSortFields(cr.Sort, fields)
 	return cr
 }
 
@@ -73,18 +94,80 @@ func (cr CursorRequest) WithDefaultSort(sorts ...Sort) CursorRequest {
 	return cr
 }
 
+// WithTieBreaker works like PageRequest.WithTieBreaker.
+func (cr CursorRequest) WithTieBreaker(tie Sort) CursorRequest {
+	cr.Sort = cr.appendTieBreaker(cr.Sort, tie)
+	return cr
+}
+
+// WithDialect sets the SQL dialect used by OrderBy and Keyset. The default is ANSI.
+func (cr CursorRequest) WithDialect(d Dialect) CursorRequest {
+	cr.dialect = d
+	return cr
+}
+
 // OrderBy returns an ORDER BY clause string from the request's sorts.
-// Returns a string like "name desc, id asc".
-// Returns an empty string if no sorts are set.
+// Returns a string like "name desc, id asc", rendered for the request's dialect.
+// Returns an empty string if no sorts are set. Bind arguments of sort
+// expressions are returned by OrderByArgs.
 func (cr CursorRequest) OrderBy() string {
-	if len(cr.Sort) == 0 {
-		return ""
-	}
-	parts := make([]string, len(cr.Sort))
-	for i, s := range cr.Sort {
-		parts[i] = s.Field + " " + string(s.Direction)
+	ob, _ := cr.orderBy(cr.Sort)
+	return ob
+}
+
+// OrderByArgs works like PageRequest.OrderByArgs.
+func (cr CursorRequest) OrderByArgs() []any {
+	_, args := cr.orderBy(cr.Sort)
+	return args
+}
+
+// MapSortExpressions works like PageRequest.MapSortExpressions.
+func (cr CursorRequest) MapSortExpressions(exprs SortExpressions) CursorRequest {
+	cr.Sort = cr.mapSortExpressions(cr.Sort, exprs)
+	return cr
+}
+
+// FilterableFields works like PageRequest.FilterableFields.
+func (cr CursorRequest) FilterableFields(fields ...string) CursorRequest {
+	cr.Filters = cr.allowQueryFilters(cr.Filters, fields)
+	return cr
+}
+
+// Where works like PageRequest.Where; AND it with Keyset().Where.
+func (cr CursorRequest) Where() string {
+	where, _ := filtersWhere(cr.Filters)
+	return where
+}
+
+// WhereArgs works like PageRequest.WhereArgs.
+func (cr CursorRequest) WhereArgs() []any {
+	_, args := filtersWhere(cr.Filters)
+	return args
+}
+
+// SelectableFields works like PageRequest.SelectableFields.
+func (cr CursorRequest) SelectableFields(fields ...string) CursorRequest {
+	cr.Fields = cr.allowQueryFields(cr.Fields, fields)
+	return cr
+}
+
+// Columns works like PageRequest.Columns.
+func (cr CursorRequest) Columns(fieldMap map[string]string, always ...string) []string {
+	return selectColumns(cr.Fields, fieldMap, always)
+}
+
+// CursorLink returns query parameters for a link to the page at cursor.
+// Sorts, filters, fields and size are carried over, in the format they were parsed
+// with, so the link reproduces the same ordering and result set. Sorts are
+// written as they were before mapping, as for PageRequest.PageLink.
+func (cr CursorRequest) CursorLink(cursor string) url.Values {
+	values := url.Values{}
+	if cursor != "" {
+		values.Set(paramCursor, cursor)
 	}
-	return strings.Join(parts, ", ")
+	values.Set(paramSize, strconv.Itoa(cr.Size))
+	cr.setLinkParams(values, cr.Sort, cr.Filters, cr.Fields)
+	return values
 }
 
 // Limit returns Size + 1 for database queries.
@@ -107,3 +190,15 @@ func (cr CursorRequest) DecodedCursor() (CursorData, error) {
 	}
 	return DecodeCursor(cr.Cursor)
 }
+
+// Keyset decodes the cursor and returns the WHERE condition, ORDER BY clause
+// and bind arguments for the next query. The cursor must carry Keys for every
+// sort field (see CursorFromItem); cursors with only Value/Extra yield no
+// condition and must be handled manually. Without a cursor, only OrderBy is set.
+func (cr CursorRequest) Keyset() (Keyset, error) {
+	data, err := cr.DecodedCursor()
+	if err != nil {
+		return Keyset{}, err
+	}
+	return cr.keyset(cr.Sort, data)
+}
@@ -167,6 +167,39 @@ func TestCursorRequestSortableFields(t *testing.T) {
 	}
 }
 
+func TestCursorRequestMapSortFieldsKeepsOptions(t *testing.T) {
+	req := CursorRequestFromQuery(url.Values{"sort": {"due,desc,nullslast", "name,asc,ci"}}).
+		WithDialect(Postgres)
+	req.Sort = append(req.Sort, Sort{Field: "title", Direction: ASC, Collation: "C"})
+	req = req.MapSortFields(map[string]string{"due": "due_date", "name": "users.name", "title": "posts.title"})
+
+	if got, want := req.OrderBy(), `due_date desc nulls last, LOWER(users.name) asc, posts.title COLLATE "C" asc`; got != want {
+		t.Errorf("OrderBy() = %q, want %q", got, want)
+	}
+
+	data, err := CursorFromItem(struct {
+		DueDate *string `db:"due_date"`
+		Name    string  `db:"name"`
+		Title   string  `db:"title"`
+	}{Name: "Bob", Title: "Go"}, req.Sort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Cursor, _ = EncodeCursor(data)
+	ks, err := req.Keyset()
+	if err != nil {
+		t.Fatalf("Keyset() error: %v", err)
+	}
+	want := `((due_date IS NULL AND (LOWER(users.name) > LOWER(?) OR users.name IS NULL)) OR ` +
+		`(due_date IS NULL AND LOWER(users.name) = LOWER(?) AND (posts.title COLLATE "C" > ? OR posts.title IS NULL)))`
+	if ks.Where != want {
+		t.Errorf("Where = %q, want %q", ks.Where, want)
+	}
+	if ks.OrderBy != req.OrderBy() {
+		t.Errorf("Keyset OrderBy = %q, want %q", ks.OrderBy, req.OrderBy())
+	}
+}
+
 func TestCursorRequestMapSortFields(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -216,6 +249,24 @@ func TestCursorRequestMapSortFields(t *testing.T) {
 	}
 }
 
+func TestCursorRequestCursorLinkRoundTrip(t *testing.T) {
+	build := func(values url.Values) CursorRequest {
+		return CursorRequestFromQuery(values).
+			SortableFields("createdAt").
+			MapSortFields(map[string]string{"createdAt": "created_at"}).
+			WithTieBreaker(Sort{Field: "id", Direction: ASC})
+	}
+	req := build(url.Values{"sort": {"createdAt,desc"}})
+
+	link := req.CursorLink("abc")
+	if got, want := link.Encode(), "cursor=abc&size=10&sort=createdAt%2Cdesc"; got != want {
+		t.Errorf("CursorLink = %q, want %q", got, want)
+	}
+	if got, want := build(link).OrderBy(), req.OrderBy(); got != want {
+		t.Errorf("OrderBy() from link = %q, want %q", got, want)
+	}
+}
+
 func TestCursorRequestWithDefaultSort(t *testing.T) {
 	// Applies default when no sort is set
 	req := CursorRequest{Size: 20}
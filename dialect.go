@@ -0,0 +1,84 @@
+package pageable
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dialect selects how SQL fragments are rendered for features whose syntax
+// differs between databases, such as null ordering.
+type Dialect string
+
+const (
+	// ANSI renders standard SQL. It is the zero value.
+	ANSI Dialect = ""
+	// Postgres renders SQL for PostgreSQL.
+	Postgres Dialect = "postgres"
+	// MySQL renders SQL for MySQL and MariaDB.
+	MySQL Dialect = "mysql"
+	// SQLite renders SQL for SQLite 3.30 or later.
+	SQLite Dialect = "sqlite"
+)
+
+// supportsNullsOrdering reports whether the dialect understands NULLS FIRST / NULLS LAST.
+func (d Dialect) supportsNullsOrdering() bool {
+	return d != MySQL
+}
+
+// defaultNulls returns where the database places nulls for dir when no null
+// ordering is given. Postgres treats nulls as larger than any value, MySQL and
+// SQLite as smaller. Returns NullsDefault when the placement is unknown.
+func (d Dialect) defaultNulls(dir Direction) NullOrder {
+	switch d {
+	case Postgres:
+		if dir == DESC {
+			return NullsFirst
+		}
+		return NullsLast
+	case MySQL, SQLite:
+		if dir == DESC {
+			return NullsLast
+		}
+		return NullsFirst
+	}
+	return NullsDefault
+}
+
+// quoteCollation renders a collation name for a COLLATE clause.
+// Postgres and ANSI SQL treat collation names as quoted identifiers.
+func (d Dialect) quoteCollation(name string) string {
+	if d == MySQL || d == SQLite {
+		return name
+	}
+	return `"` + name + `"`
+}
+
+// Rebind replaces "?" placeholders in query with the dialect's bind syntax.
+// For Postgres, placeholders become $1, $2, ...; other dialects are unchanged.
+// Question marks inside quoted strings and identifiers are left alone.
+func (d Dialect) Rebind(query string) string {
+	if d != Postgres || !strings.Contains(query, "?") {
+		return query
+	}
+
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+	n := 0
+	var quote rune
+	for _, c := range query {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
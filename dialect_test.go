@@ -0,0 +1,69 @@
+package pageable
+
+import "testing"
+
+func TestDialectRebind(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		query    string
+		expected string
+	}{
+		{Postgres, "a = ? AND b > ?", "a = $1 AND b > $2"},
+		{Postgres, "a = '?' AND \"b?\" = ?", "a = '?' AND \"b?\" = $1"},
+		{Postgres, "no placeholders", "no placeholders"},
+		{MySQL, "a = ? AND b > ?", "a = ? AND b > ?"},
+		{ANSI, "a = ?", "a = ?"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.dialect.Rebind(tt.query); got != tt.expected {
+			t.Errorf("%q.Rebind(%q) = %q, want %q", tt.dialect, tt.query, got, tt.expected)
+		}
+	}
+}
+
+func TestOrderByNulls(t *testing.T) {
+	sorts := []Sort{
+		{Field: "due", Direction: DESC, Nulls: NullsLast},
+		{Field: "rank", Direction: ASC, Nulls: NullsFirst},
+		{Field: "id", Direction: ASC},
+	}
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{ANSI, "due desc nulls last, rank asc nulls first, id asc"},
+		{Postgres, "due desc nulls last, rank asc nulls first, id asc"},
+		{SQLite, "due desc nulls last, rank asc nulls first, id asc"},
+		{MySQL, "due IS NULL asc, due desc, rank IS NULL desc, rank asc, id asc"},
+	}
+
+	for _, tt := range tests {
+		req := PageRequest{Sort: sorts}.WithDialect(tt.dialect)
+		if got := req.OrderBy(); got != tt.expected {
+			t.Errorf("%q OrderBy() = %q, want %q", tt.dialect, got, tt.expected)
+		}
+	}
+}
+
+func TestOrderByCollation(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		sort     Sort
+		expected string
+	}{
+		{Postgres, Sort{Field: "name", Direction: ASC, CaseInsensitive: true}, "LOWER(name) asc"},
+		{MySQL, Sort{Field: "name", Direction: ASC, CaseInsensitive: true}, "LOWER(name) asc"},
+		{SQLite, Sort{Field: "name", Direction: ASC, CaseInsensitive: true}, "name COLLATE NOCASE asc"},
+		{Postgres, Sort{Field: "name", Direction: DESC, Collation: "und-x-icu"}, `name COLLATE "und-x-icu" desc`},
+		{MySQL, Sort{Field: "name", Direction: ASC, Collation: "utf8mb4_0900_ai_ci"}, "name COLLATE utf8mb4_0900_ai_ci asc"},
+		{Postgres, Sort{Field: "name", Direction: ASC, Collation: `x" desc; --`}, "name asc"},
+	}
+
+	for _, tt := range tests {
+		req := CursorRequest{Sort: []Sort{tt.sort}}.WithDialect(tt.dialect)
+		if got := req.OrderBy(); got != tt.expected {
+			t.Errorf("%q OrderBy() = %q, want %q", tt.dialect, got, tt.expected)
+		}
+	}
+}
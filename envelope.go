@@ -0,0 +1,162 @@
+package pageable
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// Envelope describes the JSON shape of a page: the keys holding the items and
+// the metadata, and how metadata fields are named. The zero value renders the
+// default "items"/"metadata" envelope with camelCase keys.
+type Envelope struct {
+	// Items is the key holding the items. Empty means "items".
+	Items string
+	// Metadata is the key holding the metadata object. Empty means "metadata".
+	// Ignored when Flat is set.
+	Metadata string
+	// Flat places the metadata fields at the top level, next to the items.
+	Flat bool
+	// Keys renames metadata fields from their default JSON names
+	// (e.g., "totalItems" to "totalElements"). It takes precedence over SnakeCase.
+	Keys map[string]string
+	// SnakeCase renames the other metadata fields to snake_case
+	// (e.g., "totalItems" to "total_items").
+	SnakeCase bool
+	// Bare renders only the items as a JSON array, for clients that read the
+	// metadata from response headers (see PageMetadata.WriteHeaders).
+	// The other settings are ignored.
+	Bare bool
+}
+
+// Envelope presets. Each call returns a fresh Envelope, so changing one does
+// not affect other callers. Combine their settings for other shapes, e.g.
+// Envelope{Items: "data", Metadata: "pagination", SnakeCase: true}.
+
+// DefaultEnvelope returns the default {"items": [...], "metadata": {...}} shape.
+func DefaultEnvelope() Envelope {
+	return Envelope{}
+}
+
+// SpringEnvelope returns an envelope mimicking Spring Data's Page JSON: flat,
+// with items under "content" and "totalElements", "number", "first" and "last"
+// fields. "number" holds the request's page number as is, so parse requests
+// with WithPageBase(0) for Spring's zero-indexed page numbers.
+func SpringEnvelope() Envelope {
+	return Envelope{
+		Items: "content",
+		Flat:  true,
+		Keys: map[string]string{
+			"page":       "number",
+			"totalItems": "totalElements",
+			"isFirst":    "first",
+			"isLast":     "last",
+		},
+	}
+}
+
+// SnakeCaseEnvelope returns the default shape with snake_case metadata fields.
+func SnakeCaseEnvelope() Envelope {
+	return Envelope{SnakeCase: true}
+}
+
+// FlatEnvelope returns an envelope placing the metadata fields next to "items".
+func FlatEnvelope() Envelope {
+	return Envelope{Flat: true}
+}
+
+// BareEnvelope returns an envelope rendering the items as a bare JSON array.
+func BareEnvelope() Envelope {
+	return Envelope{Bare: true}
+}
+
+// render encodes items and metadata in the envelope's shape.
+func (e Envelope) render(items, metadata any) ([]byte, error) {
+	b, err := json.Marshal(items)
+	if err != nil || e.Bare {
+		return b, err
+	}
+	out := []member{{name: e.itemsKey(), value: b}}
+
+	b, err = json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	fields, _, err := objectMembers(b)
+	if err != nil {
+		return nil, err
+	}
+	for i, f := range fields {
+		fields[i].name = e.key(f.name)
+	}
+
+	if e.Flat {
+		out = append(out, fields...)
+	} else {
+		out = append(out, member{name: e.metadataKey(), value: writeObject(fields)})
+	}
+	return writeObject(out), nil
+}
+
+// itemsKey returns the key holding the items.
+func (e Envelope) itemsKey() string {
+	if e.Items == "" {
+		return "items"
+	}
+	return e.Items
+}
+
+// metadataKey returns the key holding the metadata object.
+func (e Envelope) metadataKey() string {
+	if e.Metadata == "" {
+		return "metadata"
+	}
+	return e.Metadata
+}
+
+// key returns the rendered name of the metadata field name.
+func (e Envelope) key(name string) string {
+	if to, ok := e.Keys[name]; ok {
+		return to
+	}
+	if e.SnakeCase {
+		return snakeCase(name)
+	}
+	return name
+}
+
+// snakeCase converts a camelCase name to snake_case (e.g., "totalItems" to "total_items").
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// pageRender holds the JSON rendering options of a page.
+type pageRender struct {
+	fields   []string
+	envelope *Envelope
+}
+
+// marshalPage encodes a page's items and metadata with the options in r.
+func marshalPage[T any](items []T, metadata any, r pageRender) ([]byte, error) {
+	selected, err := selectItems(items, r.fields)
+	if err != nil {
+		return nil, err
+	}
+	if r.envelope != nil {
+		return r.envelope.render(selected, metadata)
+	}
+	return json.Marshal(struct {
+		Items    any `json:"items"`
+		Metadata any `json:"metadata"`
+	}{selected, metadata})
+}
@@ -0,0 +1,90 @@
+package pageable
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPageWithEnvelope(t *testing.T) {
+	page := NewPage([]testItem{{ID: 1, Name: "Alice"}}, PageRequest{Page: 2, Size: 1}, 3)
+
+	tests := []struct {
+		name     string
+		envelope Envelope
+		expected string
+	}{
+		{
+			name:     "default",
+			envelope: DefaultEnvelope(),
+			expected: `{"items":[{"id":1,"name":"Alice"}],"metadata":{"page":2,"size":1,"totalItems":3,"totalPages":3}}`,
+		},
+		{
+			name:     "snake case",
+			envelope: SnakeCaseEnvelope(),
+			expected: `{"items":[{"id":1,"name":"Alice"}],"metadata":{"page":2,"size":1,"total_items":3,"total_pages":3}}`,
+		},
+		{
+			name:     "flat",
+			envelope: FlatEnvelope(),
+			expected: `{"items":[{"id":1,"name":"Alice"}],"page":2,"size":1,"totalItems":3,"totalPages":3}`,
+		},
+		{
+			name:     "spring",
+			envelope: SpringEnvelope(),
+			expected: `{"content":[{"id":1,"name":"Alice"}],"number":2,"size":1,"totalElements":3,"totalPages":3}`,
+		},
+		{
+			name:     "custom keys",
+			envelope: Envelope{Items: "data", Metadata: "pagination", SnakeCase: true, Keys: map[string]string{"totalItems": "total"}},
+			expected: `{"data":[{"id":1,"name":"Alice"}],"pagination":{"page":2,"size":1,"total":3,"total_pages":3}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(page.WithEnvelope(tt.envelope))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.expected {
+				t.Errorf("json = %s, want %s", b, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpringEnvelopeDetails(t *testing.T) {
+	page := NewPage([]testItem{{ID: 1}}, PageRequest{Page: 1, Size: 1}, 1).
+		WithDetails().
+		SelectFields("id").
+		WithEnvelope(SpringEnvelope())
+
+	b, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"content":[{"id":1}],"number":1,"size":1,"totalElements":1,"totalPages":1,` +
+		`"hasNext":false,"hasPrev":false,"first":true,"last":true,"fromItem":1,"toItem":1,"numberOfElements":1}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestCursorPageWithEnvelope(t *testing.T) {
+	page := NewCursorPage([]int{1}, "n", "", true, false, 1).
+		WithEnvelope(Envelope{Items: "data", Metadata: "pagination", SnakeCase: true})
+
+	b, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"data":[1],"pagination":{"next_cursor":"n","prev_cursor":"","has_next":true,"has_prev":false,"size":1}}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	mapped := MapCursorPage(page, func(i int) int { return i + 1 })
+	if b, _ := json.Marshal(mapped); string(b) != `{"data":[2],"pagination":{"next_cursor":"n","prev_cursor":"","has_next":true,"has_prev":false,"size":1}}` {
+		t.Errorf("mapped json = %s", b)
+	}
+}
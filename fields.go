@@ -0,0 +1,175 @@
+package pageable
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ParseFields parses a sparse fieldset as received from url.Values, where
+// ?fields=id,name&fields=avatar yields []string{"id,name", "avatar"}.
+// Values are split on commas and trimmed; duplicates and unsafe names are
+// dropped, keeping the first occurrence. Returns nil if no fields are found.
+func ParseFields(raw []string) []string {
+	var fields []string
+	seen := make(map[string]struct{})
+	for _, r := range raw {
+		for _, f := range strings.Split(r, ",") {
+			f = strings.TrimSpace(f)
+			if f == "" || !isSafeIdentifier(f) {
+				continue
+			}
+			if _, ok := seen[f]; ok {
+				continue
+			}
+			seen[f] = struct{}{}
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// setFields writes the selected fields into values as a comma-separated list.
+func setFields(values url.Values, fields []string) {
+	if len(fields) > 0 {
+		values.Set(paramFields, strings.Join(fields, ","))
+	}
+}
+
+// filterFields returns only fields in the allowed list.
+// Returns nil if no fields match.
+func filterFields(fields []string, allowed ...string) []string {
+	set := make(map[string]struct{}, len(allowed))
+	for _, f := range allowed {
+		set[f] = struct{}{}
+	}
+	var kept []string
+	for _, f := range fields {
+		if _, ok := set[f]; ok {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// selectColumns maps fields to column names and appends the always-selected
+// columns that are missing. Returns nil if no fields are selected.
+func selectColumns(fields []string, fieldMap map[string]string, always []string) []string {
+	if len(fields) == 0 {
+		return nil
+	}
+	columns := make([]string, 0, len(fields)+len(always))
+	seen := make(map[string]struct{}, cap(columns))
+	for _, f := range fields {
+		if to, ok := fieldMap[f]; ok {
+			f = to
+		}
+		if _, ok := seen[f]; !ok {
+			seen[f] = struct{}{}
+			columns = append(columns, f)
+		}
+	}
+	for _, c := range always {
+		if _, ok := seen[c]; !ok {
+			seen[c] = struct{}{}
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
+// selectItems returns items for JSON encoding with only the given top-level
+// JSON members kept, in the order the item's encoding produces them.
+// Member names come from the items' own JSON encoding, so struct tags,
+// omitempty and custom marshalers apply. Items that do not encode as JSON
+// objects are kept whole. Returns items unchanged if fields is empty.
+func selectItems[T any](items []T, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return items, nil
+	}
+	keep := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		keep[f] = struct{}{}
+	}
+
+	out := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		if out[i], err = selectMembers(b, keep); err != nil {
+			return nil, fmt.Errorf("pageable: selecting fields of item %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// selectMembers keeps only the members of the JSON object b named in keep.
+// Non-object values are returned as-is.
+func selectMembers(b []byte, keep map[string]struct{}) (json.RawMessage, error) {
+	members, ok, err := objectMembers(b)
+	if err != nil || !ok {
+		return b, err
+	}
+	kept := members[:0]
+	for _, m := range members {
+		if _, ok := keep[m.name]; ok {
+			kept = append(kept, m)
+		}
+	}
+	return writeObject(kept), nil
+}
+
+// member is a JSON object member with its raw value.
+type member struct {
+	name  string
+	value json.RawMessage
+}
+
+// objectMembers returns the members of the JSON object b in order.
+// Reports false if b is not an object.
+func objectMembers(b []byte) ([]member, bool, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, false, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, false, nil
+	}
+
+	var members []member
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, false, err
+		}
+		name, _ := tok.(string)
+		members = append(members, member{name: name, value: value})
+	}
+	return members, true, nil
+}
+
+// writeObject encodes members as a JSON object, in order.
+func writeObject(members []member) json.RawMessage {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(m.name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
@@ -0,0 +1,119 @@
+package pageable
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+type fieldsTestUser struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Avatar string `json:"avatar,omitempty"`
+}
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"nil input", nil, nil},
+		{"single list", []string{"id,name"}, []string{"id", "name"}},
+		{"repeated and trimmed", []string{" id , name", "avatar"}, []string{"id", "name", "avatar"}},
+		{"duplicates dropped", []string{"id,name,id"}, []string{"id", "name"}},
+		{"unsafe dropped", []string{"id,na me,x;y"}, []string{"id"}},
+		{"empty entries", []string{",,"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseFields(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseFields() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPageRequestSelectableFields(t *testing.T) {
+	parsed := PageRequestFromQuery(url.Values{"fields": {"id,name,password"}})
+	if parsed.Fields != nil || parsed.Columns(nil, "id") != nil {
+		t.Errorf("Fields = %v before SelectableFields, want nil (all columns)", parsed.Fields)
+	}
+	if parsed.PageLink(2).Has("fields") {
+		t.Error("PageLink should not carry fields before SelectableFields")
+	}
+
+	req := parsed.SelectableFields("id", "name", "email", "createdAt")
+
+	if !reflect.DeepEqual(req.Fields, []string{"id", "name"}) {
+		t.Errorf("Fields = %v, want [id name]", req.Fields)
+	}
+	if len(req.Filters) != 0 {
+		t.Errorf("Filters = %v, want none", req.Filters)
+	}
+
+	cols := req.Columns(map[string]string{"name": "full_name"}, "id", "created_at")
+	if want := []string{"id", "full_name", "created_at"}; !reflect.DeepEqual(cols, want) {
+		t.Errorf("Columns() = %v, want %v", cols, want)
+	}
+	if got := req.PageLink(2).Get("fields"); got != "id,name" {
+		t.Errorf("PageLink fields = %q, want %q", got, "id,name")
+	}
+
+	if cols := (PageRequest{}).Columns(nil, "id"); cols != nil {
+		t.Errorf("Columns() without fields = %v, want nil", cols)
+	}
+}
+
+func TestPageSelectFields(t *testing.T) {
+	users := []fieldsTestUser{{ID: 1, Name: "Alice", Email: "a@example.com", Avatar: "a.png"}, {ID: 2, Name: "Bob"}}
+	page := NewPage(users, PageRequest{Page: 1, Size: 10}, 2)
+
+	b, err := json.Marshal(page.SelectFields("name", "avatar", "id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"items":[{"id":1,"name":"Alice","avatar":"a.png"},{"id":2,"name":"Bob"}],` +
+		`"metadata":{"page":1,"size":10,"totalItems":2,"totalPages":1}}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	whole, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !json.Valid(whole) || len(whole) <= len(b) {
+		t.Errorf("json without fields = %s", whole)
+	}
+}
+
+func TestCursorPageSelectFields(t *testing.T) {
+	page := NewCursorPage([]fieldsTestUser{{ID: 1, Name: "Alice"}}, "n", "", true, false, 1)
+	b, err := json.Marshal(page.SelectFields("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"items":[{"id":1}],"metadata":{"nextCursor":"n","prevCursor":"","hasNext":true,"hasPrev":false,"size":1}}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestSelectFieldsNonObjectItems(t *testing.T) {
+	page := NewCursorPage([]int{1, 2}, "", "", false, false, 2)
+	b, err := json.Marshal(page.SelectFields("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded CursorPage[int]
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Items, []int{1, 2}) {
+		t.Errorf("Items = %v, want [1 2]", decoded.Items)
+	}
+}
@@ -0,0 +1,204 @@
+package pageable
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// FilterOp is the comparison operator of a Filter.
+type FilterOp string
+
+const (
+	// OpEq matches values equal to the filter value. It is the default: ?status=active.
+	OpEq FilterOp = "eq"
+	// OpNe matches values not equal to the filter value.
+	OpNe FilterOp = "ne"
+	// OpLt matches values less than the filter value.
+	OpLt FilterOp = "lt"
+	// OpLte matches values less than or equal to the filter value.
+	OpLte FilterOp = "lte"
+	// OpGt matches values greater than the filter value.
+	OpGt FilterOp = "gt"
+	// OpGte matches values greater than or equal to the filter value.
+	OpGte FilterOp = "gte"
+	// OpIn matches any of a comma-separated list of values: ?id[in]=1,2,3.
+	OpIn FilterOp = "in"
+	// OpLike matches a LIKE pattern: ?name[like]=ali%.
+	OpLike FilterOp = "like"
+	// OpIsNull matches null values for "true" and non-null values for "false".
+	OpIsNull FilterOp = "isnull"
+)
+
+// filterOpSQL maps comparison operators to their SQL form.
+var filterOpSQL = map[FilterOp]string{
+	OpEq:   "=",
+	OpNe:   "<>",
+	OpLt:   "<",
+	OpLte:  "<=",
+	OpGt:   ">",
+	OpGte:  ">=",
+	OpLike: "LIKE",
+}
+
+// Filter is a single condition parsed from a query parameter such as
+// ?status=active or ?created_at[gte]=2024-01-01.
+// Values are kept as strings and bound as-is; OpIn may hold several.
+type Filter struct {
+	Field  string
+	Op     FilterOp
+	Values []string
+}
+
+// reservedParams are query parameters that are never parsed as filters.
+var reservedParams = map[string]struct{}{
+	paramPage:   {},
+	paramSize:   {},
+	paramSort:   {},
+	paramCursor: {},
+	paramSearch: {},
+	paramFields: {},
+	paramOffset: {},
+	paramLimit:  {},
+	paramSince:  {},
+	paramUntil:  {},
+}
+
+// ParseFilters parses filters on the allowed fields from the query parameters.
+// The pagination keys ("page", "size", "sort", "cursor", "q", "fields", "offset",
+// "limit", "since", "until", or the sort parameter set by WithSortParam) and
+// parameters on other fields (e.g., "_" cache busters or "utm_source") are skipped,
+// so clients can only filter on the columns you allow.
+// Keys are "field" for equality or "field[op]" for other operators, and repeated
+// keys yield one filter each. Parameters with unsafe field names or unknown
+// operators are skipped. Filters are returned sorted by key so the result is
+// deterministic. Returns nil if no filters are found.
+func ParseFilters(values url.Values, allowed []string, opts ...QueryOption) []Filter {
+	return filterByFields(newQueryConfig(opts).parseFilters(values), allowed...)
+}
+
+// parseFilters parses filters from values, skipping the pagination keys.
+func (c queryConfig) parseFilters(values url.Values) []Filter {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if _, ok := reservedParams[k]; !ok && k != c.sortKey() {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var filters []Filter
+	for _, k := range keys {
+		for _, raw := range values[k] {
+			if f, ok := parseFilter(k, raw); ok {
+				filters = append(filters, f)
+			}
+		}
+	}
+	return filters
+}
+
+// parseFilter parses one "field" or "field[op]" parameter.
+func parseFilter(key, raw string) (Filter, bool) {
+	field, op := key, OpEq
+	if i := strings.IndexByte(key, '['); i >= 0 && strings.HasSuffix(key, "]") {
+		field, op = key[:i], FilterOp(strings.ToLower(key[i+1:len(key)-1]))
+	}
+	if field == "" || !isSafeIdentifier(field) {
+		return Filter{}, false
+	}
+
+	f := Filter{Field: field, Op: op, Values: []string{raw}}
+	switch op {
+	case OpIn:
+		f.Values = strings.Split(raw, ",")
+	case OpIsNull:
+		if raw != "true" && raw != "false" {
+			return Filter{}, false
+		}
+	default:
+		if _, ok := filterOpSQL[op]; !ok {
+			return Filter{}, false
+		}
+	}
+	return f, true
+}
+
+// key returns the query parameter key of the filter: "field" or "field[op]".
+func (f Filter) key() string {
+	if f.Op == OpEq {
+		return f.Field
+	}
+	return f.Field + "[" + string(f.Op) + "]"
+}
+
+// value returns the query parameter value of the filter.
+func (f Filter) value() string {
+	return strings.Join(f.Values, ",")
+}
+
+// SQL renders the filter as a condition with "?" placeholders and its bind arguments,
+// e.g. "created_at >= ?", "id IN (?, ?)" or "deleted_at IS NULL".
+func (f Filter) SQL() (string, []any) {
+	args := make([]any, len(f.Values))
+	for i, v := range f.Values {
+		args[i] = v
+	}
+
+	switch f.Op {
+	case OpIn:
+		return f.Field + " IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ") + ")", args
+	case OpIsNull:
+		if f.value() == "false" {
+			return f.Field + " IS NOT NULL", nil
+		}
+		return f.Field + " IS NULL", nil
+	}
+	return f.Field + " " + filterOpSQL[f.Op] + " ?", args
+}
+
+// filtersWhere joins the filters' conditions with AND.
+// Returns an empty string if there are no filters.
+func filtersWhere(filters []Filter) (string, []any) {
+	if len(filters) == 0 {
+		return "", nil
+	}
+	var args []any
+	conds := make([]string, len(filters))
+	for i, f := range filters {
+		cond, fargs := f.SQL()
+		conds[i] = cond
+		args = append(args, fargs...)
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// filterByFields returns only filters whose field is in the allowed list.
+// Returns nil if no filters match.
+func filterByFields(filters []Filter, fields ...string) []Filter {
+	allowed := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		allowed[f] = struct{}{}
+	}
+	var kept []Filter
+	for _, f := range filters {
+		if _, ok := allowed[f.Field]; ok {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// allowFilters returns the filters and the pending filters parsed from the
+// query that are on the allowed fields. It backs FilterableFields, which moves
+// pending filters into a request's Filters.
+func allowFilters(filters, pending []Filter, fields ...string) []Filter {
+	return filterByFields(append(filters[:len(filters):len(filters)], pending...), fields...)
+}
+
+// setFilters writes filters into values as query parameters.
+func setFilters(values url.Values, filters []Filter) {
+	for _, f := range filters {
+		values.Add(f.key(), f.value())
+	}
+}
@@ -0,0 +1,192 @@
+package pageable
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   url.Values
+		expected []Filter
+	}{
+		{
+			name:     "no filters",
+			values:   url.Values{"page": {"2"}, "size": {"5"}, "sort": {"id,desc"}, "cursor": {"abc"}},
+			expected: nil,
+		},
+		{
+			name:     "plain key is equality",
+			values:   url.Values{"status": {"active"}},
+			expected: []Filter{{Field: "status", Op: OpEq, Values: []string{"active"}}},
+		},
+		{
+			name:     "bracketed operator",
+			values:   url.Values{"created_at[gte]": {"2024-01-01"}},
+			expected: []Filter{{Field: "created_at", Op: OpGte, Values: []string{"2024-01-01"}}},
+		},
+		{
+			name:     "operator is case insensitive",
+			values:   url.Values{"age[LT]": {"30"}},
+			expected: []Filter{{Field: "age", Op: OpLt, Values: []string{"30"}}},
+		},
+		{
+			name:     "in splits values",
+			values:   url.Values{"id[in]": {"1,2,3"}},
+			expected: []Filter{{Field: "id", Op: OpIn, Values: []string{"1", "2", "3"}}},
+		},
+		{
+			name:     "isnull",
+			values:   url.Values{"deleted_at[isnull]": {"true"}},
+			expected: []Filter{{Field: "deleted_at", Op: OpIsNull, Values: []string{"true"}}},
+		},
+		{
+			name:     "isnull with invalid value skipped",
+			values:   url.Values{"deleted_at[isnull]": {"yes"}},
+			expected: nil,
+		},
+		{
+			name:     "unknown operator skipped",
+			values:   url.Values{"age[between]": {"1"}},
+			expected: nil,
+		},
+		{
+			name:     "unsafe field skipped",
+			values:   url.Values{"id;DROP TABLE users": {"1"}},
+			expected: nil,
+		},
+		{
+			name:   "repeated keys and sorted output",
+			values: url.Values{"status": {"active"}, "age[gte]": {"18", "21"}},
+			expected: []Filter{
+				{Field: "age", Op: OpGte, Values: []string{"18"}},
+				{Field: "age", Op: OpGte, Values: []string{"21"}},
+				{Field: "status", Op: OpEq, Values: []string{"active"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed := []string{"status", "created_at", "age", "id", "deleted_at", "id;DROP TABLE users"}
+			if got := ParseFilters(tt.values, allowed); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseFilters() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFiltersSortParam(t *testing.T) {
+	got := ParseFilters(url.Values{"order_by": {"id"}, "status": {"active"}}, []string{"order_by", "status"}, WithSortParam("order_by"))
+	if len(got) != 1 || got[0].Field != "status" {
+		t.Errorf("ParseFilters() = %v, want only status", got)
+	}
+}
+
+func TestParseFiltersAllowlist(t *testing.T) {
+	values := url.Values{"_": {"123"}, "utm_source": {"x"}, "password_hash[like]": {"a%"}, "status": {"active"}}
+	got := ParseFilters(values, []string{"status"})
+	if want := []Filter{{Field: "status", Op: OpEq, Values: []string{"active"}}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseFilters() = %v, want %v", got, want)
+	}
+	if got := ParseFilters(values, nil); got != nil {
+		t.Errorf("ParseFilters() without allowed fields = %v, want nil", got)
+	}
+}
+
+func TestFilterSQL(t *testing.T) {
+	tests := []struct {
+		filter   Filter
+		expected string
+		args     []any
+	}{
+		{Filter{Field: "status", Op: OpEq, Values: []string{"active"}}, "status = ?", []any{"active"}},
+		{Filter{Field: "status", Op: OpNe, Values: []string{"active"}}, "status <> ?", []any{"active"}},
+		{Filter{Field: "age", Op: OpLte, Values: []string{"30"}}, "age <= ?", []any{"30"}},
+		{Filter{Field: "name", Op: OpLike, Values: []string{"al%"}}, "name LIKE ?", []any{"al%"}},
+		{Filter{Field: "id", Op: OpIn, Values: []string{"1", "2"}}, "id IN (?, ?)", []any{"1", "2"}},
+		{Filter{Field: "deleted_at", Op: OpIsNull, Values: []string{"true"}}, "deleted_at IS NULL", nil},
+		{Filter{Field: "deleted_at", Op: OpIsNull, Values: []string{"false"}}, "deleted_at IS NOT NULL", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got, args := tt.filter.SQL()
+			if got != tt.expected {
+				t.Errorf("SQL() = %q, want %q", got, tt.expected)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestPageRequestFilters(t *testing.T) {
+	values := url.Values{"page": {"2"}, "status": {"active"}, "age[gt]": {"18"}, "secret": {"x"}}
+	req := PageRequestFromQuery(values).FilterableFields("status", "age")
+
+	if got, want := req.Where(), "age > ? AND status = ?"; got != want {
+		t.Errorf("Where() = %q, want %q", got, want)
+	}
+	if got, want := req.WhereArgs(), []any{"18", "active"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WhereArgs() = %v, want %v", got, want)
+	}
+	if got, want := req.PageLink(3).Encode(), "age%5Bgt%5D=18&page=3&size=10&status=active"; got != want {
+		t.Errorf("PageLink = %q, want %q", got, want)
+	}
+	if cr := req.ToCursorRequest("abc"); !reflect.DeepEqual(cr.Filters, req.Filters) {
+		t.Errorf("ToCursorRequest().Filters = %v, want %v", cr.Filters, req.Filters)
+	}
+}
+
+func TestRequestFiltersRequireAllowlist(t *testing.T) {
+	values := url.Values{"_": {"123"}, "utm_source": {"x"}, "password_hash[like]": {"a%"}}
+
+	pr := PageRequestFromQuery(values)
+	if got := pr.Where(); got != "" {
+		t.Errorf("PageRequest.Where() = %q, want empty", got)
+	}
+	if got := pr.PageLink(2).Encode(); got != "page=2&size=10" {
+		t.Errorf("PageLink = %q, want no filters", got)
+	}
+	if got := pr.FilterableFields("status").Where(); got != "" {
+		t.Errorf("Where() with unrelated allowlist = %q, want empty", got)
+	}
+	if got := CursorRequestFromQuery(values).Where(); got != "" {
+		t.Errorf("CursorRequest.Where() = %q, want empty", got)
+	}
+	if got := HybridRequestFromQuery(values).Where(); got != "" {
+		t.Errorf("HybridRequest.Where() = %q, want empty", got)
+	}
+	if got := OffsetRequestFromQuery(values).Where(); got != "" {
+		t.Errorf("OffsetRequest.Where() = %q, want empty", got)
+	}
+
+	// Server-set filters are trusted and kept alongside allowed query filters.
+	pr = PageRequestFromQuery(url.Values{"status": {"active"}})
+	pr.Filters = []Filter{{Field: "tenant_id", Op: OpEq, Values: []string{"7"}}}
+	if got, want := pr.Where(), "tenant_id = ?"; got != want {
+		t.Errorf("Where() = %q, want %q", got, want)
+	}
+	if got, want := pr.FilterableFields("tenant_id", "status").Where(), "tenant_id = ? AND status = ?"; got != want {
+		t.Errorf("Where() = %q, want %q", got, want)
+	}
+}
+
+func TestCursorRequestFilters(t *testing.T) {
+	values := url.Values{"cursor": {"abc"}, "id[in]": {"1,2"}}
+	req := CursorRequestFromQuery(values).FilterableFields("id")
+
+	if got, want := req.Where(), "id IN (?, ?)"; got != want {
+		t.Errorf("Where() = %q, want %q", got, want)
+	}
+	if got, want := req.CursorLink("def").Encode(), "cursor=def&id%5Bin%5D=1%2C2&size=10"; got != want {
+		t.Errorf("CursorLink = %q, want %q", got, want)
+	}
+	if got := req.FilterableFields("status").Where(); got != "" {
+		t.Errorf("Where() = %q, want empty", got)
+	}
+}
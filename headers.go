@@ -0,0 +1,65 @@
+package pageable
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Pagination response headers, for clients that read pagination from headers
+// instead of the body. Cross-origin clients can only read them if they are
+// listed in Access-Control-Expose-Headers.
+const (
+	HeaderTotalCount = "X-Total-Count"
+	HeaderPage       = "X-Page"
+	HeaderPerPage    = "X-Per-Page"
+	HeaderTotalPages = "X-Total-Pages"
+	HeaderNextCursor = "X-Next-Cursor"
+	HeaderPrevCursor = "X-Prev-Cursor"
+	HeaderHasNext    = "X-Has-Next"
+	HeaderHasPrev    = "X-Has-Prev"
+	HeaderHeadCursor = "X-Head-Cursor"
+	HeaderHasNewer   = "X-Has-Newer"
+)
+
+// WriteHeaders sets X-Total-Count, X-Page, X-Per-Page, X-Total-Pages and
+// Content-Range (e.g., "items 20-39/95", with zero-based inclusive item
+// positions, or "items */95" for a page past the end) on h.
+func (m PageMetadata) WriteHeaders(h http.Header) {
+	h.Set(HeaderTotalCount, strconv.FormatInt(m.TotalItems, 10))
+	h.Set(HeaderPage, strconv.Itoa(m.Page))
+	h.Set(HeaderPerPage, strconv.Itoa(m.Size))
+	h.Set(HeaderTotalPages, strconv.Itoa(m.TotalPages))
+	h.Set("Content-Range", m.contentRange())
+}
+
+// contentRange returns the Content-Range value for the page's items.
+func (m PageMetadata) contentRange() string {
+	first := m.firstItem()
+	last := min(first+int64(m.Size), m.TotalItems) - 1
+	total := strconv.FormatInt(m.TotalItems, 10)
+	if first < 0 || last < first {
+		return "items */" + total
+	}
+	return "items " + strconv.FormatInt(first, 10) + "-" + strconv.FormatInt(last, 10) + "/" + total
+}
+
+// WriteHeaders sets X-Per-Page, X-Has-Next and X-Has-Prev on h, and
+// X-Next-Cursor, X-Prev-Cursor and X-Head-Cursor when the cursors are set,
+// and X-Has-Newer on head pages with more newer items.
+func (m CursorPageMetadata) WriteHeaders(h http.Header) {
+	h.Set(HeaderPerPage, strconv.Itoa(m.Size))
+	h.Set(HeaderHasNext, strconv.FormatBool(m.HasNext))
+	h.Set(HeaderHasPrev, strconv.FormatBool(m.HasPrev))
+	if m.NextCursor != "" {
+		h.Set(HeaderNextCursor, m.NextCursor)
+	}
+	if m.PrevCursor != "" {
+		h.Set(HeaderPrevCursor, m.PrevCursor)
+	}
+	if m.HeadCursor != "" {
+		h.Set(HeaderHeadCursor, m.HeadCursor)
+	}
+	if m.HasNewer {
+		h.Set(HeaderHasNewer, "true")
+	}
+}
@@ -0,0 +1,96 @@
+package pageable
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestPageMetadataWriteHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata PageMetadata
+		expected map[string]string
+	}{
+		{
+			name:     "middle page",
+			metadata: PageMetadata{Page: 2, Size: 20, TotalItems: 95, TotalPages: 5},
+			expected: map[string]string{
+				"X-Total-Count": "95",
+				"X-Page":        "2",
+				"X-Per-Page":    "20",
+				"X-Total-Pages": "5",
+				"Content-Range": "items 20-39/95",
+			},
+		},
+		{
+			name:     "last partial page",
+			metadata: PageMetadata{Page: 5, Size: 20, TotalItems: 95, TotalPages: 5},
+			expected: map[string]string{"Content-Range": "items 80-94/95"},
+		},
+		{
+			name:     "past the end",
+			metadata: PageMetadata{Page: 9, Size: 20, TotalItems: 95, TotalPages: 5},
+			expected: map[string]string{"Content-Range": "items */95"},
+		},
+		{
+			name:     "empty",
+			metadata: PageMetadata{Page: 1, Size: 20},
+			expected: map[string]string{"X-Total-Count": "0", "Content-Range": "items */0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			tt.metadata.WriteHeaders(h)
+			for k, want := range tt.expected {
+				if got := h.Get(k); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCursorPageMetadataWriteHeaders(t *testing.T) {
+	h := http.Header{}
+	CursorPageMetadata{NextCursor: "abc", HasNext: true, Size: 10, HeadCursor: "top", HasNewer: true}.WriteHeaders(h)
+
+	expected := map[string]string{
+		"X-Next-Cursor": "abc",
+		"X-Head-Cursor": "top",
+		"X-Has-Newer":   "true",
+		"X-Has-Next":    "true",
+		"X-Has-Prev":    "false",
+		"X-Per-Page":    "10",
+	}
+	for k, want := range expected {
+		if got := h.Get(k); got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+	if _, ok := h["X-Prev-Cursor"]; ok {
+		t.Error("unexpected X-Prev-Cursor header")
+	}
+}
+
+func TestBareEnvelope(t *testing.T) {
+	b, err := json.Marshal(NewPage([]testItem{{ID: 1, Name: "Alice"}}, PageRequest{Page: 1, Size: 10}, 1).
+		SelectFields("id").
+		WithEnvelope(BareEnvelope()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `[{"id":1}]` {
+		t.Errorf("json = %s", b)
+	}
+
+	b, err = json.Marshal(EmptyCursorPage[testItem](10).WithEnvelope(BareEnvelope()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `[]` {
+		t.Errorf("json = %s, want []", b)
+	}
+}
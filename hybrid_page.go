@@ -0,0 +1,64 @@
+package pageable
+
+// HybridPageMetadata holds pagination metadata for numbered pages served by keyset queries.
+// It combines the page numbers of PageMetadata with the cursors of CursorPageMetadata.
+type HybridPageMetadata struct {
+	Page       int    `json:"page"`
+	Size       int    `json:"size"`
+	TotalItems int64  `json:"totalItems"`
+	TotalPages int    `json:"totalPages"`
+	NextCursor string `json:"nextCursor"`
+	PrevCursor string `json:"prevCursor"`
+	HasNext    bool   `json:"hasNext"`
+	HasPrev    bool   `json:"hasPrev"`
+}
+
+// HybridPage represents a paginated response for HybridRequest.
+type HybridPage[T any] struct {
+	Items    []T                `json:"items"`
+	Metadata HybridPageMetadata `json:"metadata"`
+
+	render pageRender
+}
+
+// NewHybridPage creates a HybridPage from items, request parameters, cursors and total item count.
+// The cursors should be produced with HybridRequest.NextCursor and HybridRequest.PrevCursor.
+// HasPrev is true for every page after the first. Page follows the request's page base.
+// A nil items slice is converted to an empty slice to ensure JSON serializes as [] not null.
+func NewHybridPage[T any](items []T, request HybridRequest, nextCursor, prevCursor string, hasNext bool, totalItems int64) HybridPage[T] {
+	page := NewPage(items, PageRequest{Page: request.Page, Size: request.Size}, totalItems)
+	return HybridPage[T]{
+		Items: page.Items,
+		Metadata: HybridPageMetadata{
+			Page:       request.Page - DefaultPage + request.query.firstPage(),
+			Size:       page.Metadata.Size,
+			TotalItems: page.Metadata.TotalItems,
+			TotalPages: page.Metadata.TotalPages,
+			NextCursor: nextCursor,
+			PrevCursor: prevCursor,
+			HasNext:    hasNext,
+			HasPrev:    request.Page > 1,
+		},
+	}
+}
+
+// SelectFields returns the page with items marshaled to JSON with only the
+// given top-level fields (e.g., the request's Fields), named as in the items'
+// JSON encoding. The envelope is unchanged. No fields marshals items whole.
+func (p HybridPage[T]) SelectFields(fields ...string) HybridPage[T] {
+	p.render.fields = fields
+	return p
+}
+
+// WithEnvelope returns the page marshaled to JSON with the key names and
+// shape of e instead of the default "items"/"metadata" envelope.
+func (p HybridPage[T]) WithEnvelope(e Envelope) HybridPage[T] {
+	p.render.envelope = &e
+	return p
+}
+
+// MarshalJSON implements json.Marshaler, applying the fields set by
+// SelectFields and the envelope set by WithEnvelope.
+func (p HybridPage[T]) MarshalJSON() ([]byte, error) {
+	return marshalPage(p.Items, p.Metadata, p.render)
+}
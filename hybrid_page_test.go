@@ -0,0 +1,49 @@
+package pageable
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewHybridPage(t *testing.T) {
+	items := []testItem{{ID: 31, Name: "Alice"}, {ID: 32, Name: "Bob"}}
+	req := HybridRequest{Page: 4, Size: 10}
+
+	page := NewHybridPage(items, req, "next", "prev", true, 95)
+
+	if len(page.Items) != 2 {
+		t.Errorf("Items length = %d, want 2", len(page.Items))
+	}
+	want := HybridPageMetadata{
+		Page:       4,
+		Size:       10,
+		TotalItems: 95,
+		TotalPages: 10,
+		NextCursor: "next",
+		PrevCursor: "prev",
+		HasNext:    true,
+		HasPrev:    true,
+	}
+	if page.Metadata != want {
+		t.Errorf("Metadata = %+v, want %+v", page.Metadata, want)
+	}
+}
+
+func TestNewHybridPageFirstPage(t *testing.T) {
+	page := NewHybridPage[testItem](nil, HybridRequest{Page: 1, Size: 10}, "", "", false, 0)
+	if page.Metadata.HasPrev {
+		t.Error("HasPrev should be false on the first page")
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if string(raw["items"]) != "[]" {
+		t.Errorf("items = %s, want []", raw["items"])
+	}
+}
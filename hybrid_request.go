@@ -0,0 +1,274 @@
+package pageable
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// HybridRequest represents numbered-page pagination served by keyset queries.
+// Each page link carries a cursor together with the page number it leads to.
+// The cursor anchors the keyset query, and Skip covers any pages jumped past
+// the anchor, so OFFSET stays bounded by the max page jump (see WithMaxPageJump).
+// Pages farther away are reached by following page links: each served page
+// mints cursors for its neighbors, so clients walk toward distant pages,
+// including the last, one bounded jump at a time.
+type HybridRequest struct {
+	Page    int
+	Cursor  string
+	Size    int
+	Sort    []Sort
+	Filters []Filter
+	Fields  []string
+
+	requestState
+	// maxJump overrides MaxPageJump when maxJumpSet is true (see WithMaxPageJump).
+	maxJump    int
+	maxJumpSet bool
+}
+
+// NewHybridRequest creates a HybridRequest with defaults applied, like
+// NewPageRequest. With WithPageBase(0), page is zero-based and stored 1-based
+// in Page, as for HybridRequestFromQuery.
+func NewHybridRequest(page int, cursor string, size int, sort []Sort, opts ...QueryOption) HybridRequest {
+	pr := NewPageRequest(page, size, sort, opts...)
+	return HybridRequest{
+		Page:         pr.Page - pr.query.firstPage() + DefaultPage,
+		Cursor:       cursor,
+		Size:         pr.Size,
+		Sort:         pr.Sort,
+		requestState: pr.requestState,
+	}
+}
+
+// HybridRequestFromQuery parses a HybridRequest from URL query parameters.
+// Recognized keys: "page", "cursor", "size", "sort", "fields"; all other keys
+// are parsed as filters (see ParseFilters), applied once allowed by FilterableFields.
+// Uses DefaultPage and DefaultSize for missing or invalid values. Options change how sorts
+// and page numbers are read. With WithPageBase(0), the parsed page is stored
+// 1-based in Page, and NewHybridPage reports it zero-based again.
+func HybridRequestFromQuery(values url.Values, opts ...QueryOption) HybridRequest {
+	pr := PageRequestFromQuery(values, opts...)
+	return HybridRequest{
+		Page:         pr.Page - pr.query.firstPage() + DefaultPage,
+		Cursor:       values.Get(paramCursor),
+		Size:         pr.Size,
+		Sort:         pr.Sort,
+		Filters:      pr.Filters,
+		Fields:       pr.Fields,
+		requestState: pr.requestState,
+	}
+}
+
+// SortableFields works like PageRequest.SortableFields.
+func (hr HybridRequest) SortableFields(fields ...string) HybridRequest {
+	hr.Sort = filterSortsByFields(hr.Sort, fields...)
+	return hr
+}
+
+// ApplySortPolicy works like PageRequest.ApplySortPolicy.
+func (hr HybridRequest) ApplySortPolicy(policy SortPolicy) (HybridRequest, error) {
+	sorts, err := policy.Apply(hr.Sort)
+	if err != nil {
+		return hr, err
+	}
+	hr.Sort = sorts
+	return hr, nil
+}
+
+// MapSortFields works like PageRequest.MapSortFields.
+func (hr HybridRequest) MapSortFields(fieldMap map[string]string) HybridRequest {
+	hr.Sort = hr.mapSortFields(hr.Sort, fieldMap)
+	return hr
+}
+
+// MapJSONSortFields works like PageRequest.MapJSONSortFields.
+func (hr HybridRequest) MapJSONSortFields(fields JSONSortFields) HybridRequest {
+	hr.Sort = hr.mapJSONSortFields(hr.Sort, fields)
+	return hr
+}
+
+// WithDefaultSort works like PageRequest.WithDefaultSort.
+func (hr HybridRequest) WithDefaultSort(sorts ...Sort) HybridRequest {
+	if hr.Sort == nil {
+		hr.Sort = sorts
+	}
+	return hr
+}
+
+// WithTieBreaker works like PageRequest.WithTieBreaker.
+func (hr HybridRequest) WithTieBreaker(tie Sort) HybridRequest {
+	hr.Sort = hr.appendTieBreaker(hr.Sort, tie)
+	return hr
+}
+
+// WithDialect sets the SQL dialect used by OrderBy and Keyset. The default is ANSI.
+func (hr HybridRequest) WithDialect(d Dialect) HybridRequest {
+	hr.dialect = d
+	return hr
+}
+
+// OrderBy works like PageRequest.OrderBy.
+func (hr HybridRequest) OrderBy() string {
+	ob, _ := hr.orderBy(hr.Sort)
+	return ob
+}
+
+// OrderByArgs works like PageRequest.OrderByArgs.
+func (hr HybridRequest) OrderByArgs() []any {
+	_, args := hr.orderBy(hr.Sort)
+	return args
+}
+
+// MapSortExpressions works like PageRequest.MapSortExpressions.
+func (hr HybridRequest) MapSortExpressions(exprs SortExpressions) HybridRequest {
+	hr.Sort = hr.mapSortExpressions(hr.Sort, exprs)
+	return hr
+}
+
+// FilterableFields works like PageRequest.FilterableFields.
+func (hr HybridRequest) FilterableFields(fields ...string) HybridRequest {
+	hr.Filters = hr.allowQueryFilters(hr.Filters, fields)
+	return hr
+}
+
+// Where works like PageRequest.Where; AND it with Keyset().Where.
+func (hr HybridRequest) Where() string {
+	where, _ := filtersWhere(hr.Filters)
+	return where
+}
+
+// WhereArgs works like PageRequest.WhereArgs.
+func (hr HybridRequest) WhereArgs() []any {
+	_, args := filtersWhere(hr.Filters)
+	return args
+}
+
+// SelectableFields works like PageRequest.SelectableFields.
+func (hr HybridRequest) SelectableFields(fields ...string) HybridRequest {
+	hr.Fields = hr.allowQueryFields(hr.Fields, fields)
+	return hr
+}
+
+// Columns works like PageRequest.Columns.
+func (hr HybridRequest) Columns(fieldMap map[string]string, always ...string) []string {
+	return selectColumns(hr.Fields, fieldMap, always)
+}
+
+// WithMaxPageJump sets the number of pages Skip may jump past the cursor, or
+// past the start without one, so OFFSET never exceeds n*Size rows. Derive it
+// from the database's OFFSET budget, e.g. DefaultMaxOffset/req.Size. With 0,
+// every page but the first needs a cursor for the page itself. Negative values
+// are ignored. The default is MaxPageJump. Link only to pages within n of the
+// current page (e.g., with PageMetadata.PageWindow) so every link can be served.
+func (hr HybridRequest) WithMaxPageJump(n int) HybridRequest {
+	if n >= 0 {
+		hr.maxJump, hr.maxJumpSet = n, true
+	}
+	return hr
+}
+
+// maxPageJump returns the jump limit set by WithMaxPageJump, or MaxPageJump.
+func (hr HybridRequest) maxPageJump() int {
+	if hr.maxJumpSet {
+		return hr.maxJump
+	}
+	return MaxPageJump
+}
+
+// Limit returns Size + 1 for database queries, so hasNext can be detected
+// without a COUNT query.
+func (hr HybridRequest) Limit() int {
+	return hr.Size + 1
+}
+
+// HasCursor returns true if a non-empty cursor was provided.
+func (hr HybridRequest) HasCursor() bool {
+	return hr.Cursor != ""
+}
+
+// DecodedCursor decodes and returns the full CursorData.
+// Returns (CursorData{}, nil) if no cursor is set.
+func (hr HybridRequest) DecodedCursor() (CursorData, error) {
+	if hr.Cursor == "" {
+		return CursorData{}, nil
+	}
+	return DecodeCursor(hr.Cursor)
+}
+
+// Keyset decodes the cursor and returns the WHERE condition, ORDER BY clause
+// and bind arguments for the query, to be combined with OFFSET Skip().
+// Without a cursor, only OrderBy is set.
+func (hr HybridRequest) Keyset() (Keyset, error) {
+	data, err := hr.DecodedCursor()
+	if err != nil {
+		return Keyset{}, err
+	}
+	return hr.keyset(hr.Sort, data)
+}
+
+// Skip returns the number of rows to skip after the keyset predicate
+// (the OFFSET of the query) to reach the requested page.
+// Without a cursor, pages are counted from the start of the result set.
+// A Next cursor leading to page a serves pages a and after; a Prev cursor
+// leading to page a serves pages a and before, queried in reverse order.
+// Returns an error if the cursor cannot reach the page or the jump exceeds
+// the max page jump (see WithMaxPageJump).
+func (hr HybridRequest) Skip() (int, error) {
+	data, err := hr.DecodedCursor()
+	if err != nil {
+		return 0, err
+	}
+
+	jump := hr.Page - 1
+	if hr.HasCursor() {
+		if data.Page < 1 {
+			return 0, errors.New("pageable: cursor has no page number")
+		}
+		jump = hr.Page - data.Page
+		if data.Direction == Prev {
+			jump = data.Page - hr.Page
+		}
+	}
+	if jump < 0 {
+		return 0, fmt.Errorf("pageable: page %d is not reachable from cursor for page %d", hr.Page, data.Page)
+	}
+	if limit := hr.maxPageJump(); jump > limit {
+		return 0, fmt.Errorf("pageable: page %d is %d pages from the nearest cursor (max %d)", hr.Page, jump, limit)
+	}
+	return jump * hr.Size, nil
+}
+
+// NextCursor encodes data as a Next cursor leading to the page after this one.
+// Typically data holds the keyset values of the last item on the page.
+func (hr HybridRequest) NextCursor(data CursorData) (string, error) {
+	data.Direction = Next
+	data.Page = hr.Page + 1
+	return EncodeCursor(data)
+}
+
+// PrevCursor encodes data as a Prev cursor leading to the page before this one.
+// Typically data holds the keyset values of the first item on the page.
+func (hr HybridRequest) PrevCursor(data CursorData) (string, error) {
+	data.Direction = Prev
+	data.Page = hr.Page - 1
+	return EncodeCursor(data)
+}
+
+// PageLink returns query parameters for a link to page, anchored at cursor.
+// page is numbered like HybridPageMetadata.Page, in the request's page base.
+// Sorts, filters, fields and size are carried over, in the format they were parsed
+// with, so the link reproduces the same ordering and result set. Sorts are
+// written as they were before mapping, as for PageRequest.PageLink, so the
+// cursor's keys match the sorts the link parses back to.
+func (hr HybridRequest) PageLink(page int, cursor string) url.Values {
+	values := url.Values{}
+	values.Set(paramPage, strconv.Itoa(page))
+	if cursor != "" {
+		values.Set(paramCursor, cursor)
+	}
+	values.Set(paramSize, strconv.Itoa(hr.Size))
+	hr.setLinkParams(values, hr.Sort, hr.Filters, hr.Fields)
+	return values
+}
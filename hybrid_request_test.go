@@ -0,0 +1,182 @@
+package pageable
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestHybridRequestFromQuery(t *testing.T) {
+	values := url.Values{"page": {"3"}, "cursor": {"abc"}, "size": {"5000"}, "sort": {"name,desc"}}
+	req := HybridRequestFromQuery(values)
+	if req.Page != 3 {
+		t.Errorf("Page = %d, want 3", req.Page)
+	}
+	if req.Cursor != "abc" {
+		t.Errorf("Cursor = %q, want %q", req.Cursor, "abc")
+	}
+	if req.Size != MaxSize {
+		t.Errorf("Size = %d, want %d", req.Size, MaxSize)
+	}
+	if len(req.Sort) != 1 || req.Sort[0] != (Sort{Field: "name", Direction: DESC}) {
+		t.Errorf("Sort = %v, want [{name desc}]", req.Sort)
+	}
+}
+
+func TestNewHybridRequest(t *testing.T) {
+	req := NewHybridRequest(0, "", 0, nil)
+	if req.Page != DefaultPage || req.Size != DefaultSize {
+		t.Errorf("got Page=%d Size=%d, want defaults", req.Page, req.Size)
+	}
+}
+
+func TestHybridRequestSkip(t *testing.T) {
+	next := func(page int) string {
+		c, _ := EncodeCursor(CursorData{Value: "42", Direction: Next, Page: page})
+		return c
+	}
+	prev := func(page int) string {
+		c, _ := EncodeCursor(CursorData{Value: "42", Direction: Prev, Page: page})
+		return c
+	}
+	noPage, _ := EncodeCursor(CursorData{Value: "42", Direction: Next})
+
+	tests := []struct {
+		name     string
+		page     int
+		cursor   string
+		wantSkip int
+		wantErr  bool
+	}{
+		{"first page without cursor", 1, "", 0, false},
+		{"small page without cursor", 3, "", 20, false},
+		{"deep page without cursor", 50, "", 0, true},
+		{"next cursor for same page", 4, next(4), 0, false},
+		{"jump ahead from next cursor", 7, next(4), 30, false},
+		{"jump too far from next cursor", 30, next(4), 0, true},
+		{"behind next cursor", 2, next(4), 0, true},
+		{"prev cursor for same page", 3, prev(3), 0, false},
+		{"jump back from prev cursor", 1, prev(3), 20, false},
+		{"ahead of prev cursor", 5, prev(3), 0, true},
+		{"cursor without page", 2, noPage, 0, true},
+		{"invalid cursor", 2, "!!!", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := HybridRequest{Page: tt.page, Cursor: tt.cursor, Size: 10}
+			skip, err := req.Skip()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Skip() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if skip != tt.wantSkip {
+				t.Errorf("Skip() = %d, want %d", skip, tt.wantSkip)
+			}
+		})
+	}
+}
+
+func TestHybridRequestWithMaxPageJump(t *testing.T) {
+	next, _ := EncodeCursor(CursorData{Value: "42", Direction: Next, Page: 4})
+
+	tests := []struct {
+		name     string
+		page     int
+		cursor   string
+		maxJump  int
+		wantSkip int
+		wantErr  bool
+	}{
+		{"deep page within raised limit", 50, "", 100, 490, false},
+		{"jump within lowered limit", 6, next, 2, 20, false},
+		{"jump past lowered limit", 7, next, 2, 0, true},
+		{"no jumps allows cursor page", 4, next, 0, 0, false},
+		{"no jumps rejects second page", 2, "", 0, 0, true},
+		{"negative keeps default", 11, "", -1, 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := HybridRequest{Page: tt.page, Cursor: tt.cursor, Size: 10}.WithMaxPageJump(tt.maxJump)
+			skip, err := req.Skip()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Skip() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if skip != tt.wantSkip {
+				t.Errorf("Skip() = %d, want %d", skip, tt.wantSkip)
+			}
+		})
+	}
+}
+
+func TestHybridRequestCursors(t *testing.T) {
+	req := HybridRequest{Page: 4, Size: 10}
+
+	next, err := req.NextCursor(CursorData{Value: "40"})
+	if err != nil {
+		t.Fatalf("NextCursor error: %v", err)
+	}
+	data, _ := DecodeCursor(next)
+	if data.Direction != Next || data.Page != 5 || data.Value != "40" {
+		t.Errorf("next cursor = %+v", data)
+	}
+
+	prev, err := req.PrevCursor(CursorData{Value: "31"})
+	if err != nil {
+		t.Fatalf("PrevCursor error: %v", err)
+	}
+	data, _ = DecodeCursor(prev)
+	if data.Direction != Prev || data.Page != 3 || data.Value != "31" {
+		t.Errorf("prev cursor = %+v", data)
+	}
+}
+
+func TestHybridRequestPageLink(t *testing.T) {
+	req := HybridRequest{Page: 4, Size: 10, Sort: []Sort{{Field: "id", Direction: ASC}}}
+	got := req.PageLink(6, "abc").Encode()
+	want := "cursor=abc&page=6&size=10&sort=id%2Casc"
+	if got != want {
+		t.Errorf("PageLink = %q, want %q", got, want)
+	}
+}
+
+func TestHybridRequestPageLinkRoundTrip(t *testing.T) {
+	build := func(values url.Values) HybridRequest {
+		return HybridRequestFromQuery(values).
+			SortableFields("createdAt").
+			MapSortFields(map[string]string{"createdAt": "created_at"}).
+			WithTieBreaker(Sort{Field: "id", Direction: ASC})
+	}
+	req := build(url.Values{"sort": {"createdAt,desc"}})
+
+	last := struct {
+		ID        int64  `db:"id"`
+		CreatedAt string `db:"created_at"`
+	}{ID: 10, CreatedAt: "2024-01-02"}
+	data, err := CursorFromItem(last, req.Sort)
+	if err != nil {
+		t.Fatalf("CursorFromItem error: %v", err)
+	}
+	next, err := req.NextCursor(data)
+	if err != nil {
+		t.Fatalf("NextCursor error: %v", err)
+	}
+
+	got := build(req.PageLink(2, next))
+	if got.OrderBy() != req.OrderBy() {
+		t.Errorf("OrderBy() from link = %q, want %q", got.OrderBy(), req.OrderBy())
+	}
+	ks, err := got.Keyset()
+	if err != nil {
+		t.Fatalf("Keyset() from link error: %v", err)
+	}
+	if want := "((created_at < ?) OR (created_at = ? AND id > ?))"; ks.Where != want {
+		t.Errorf("Keyset().Where = %q, want %q", ks.Where, want)
+	}
+}
+
+func TestHybridRequestLimit(t *testing.T) {
+	req := HybridRequest{Size: 25}
+	if got := req.Limit(); got != 26 {
+		t.Errorf("Limit() = %d, want 26", got)
+	}
+}
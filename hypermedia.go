@@ -0,0 +1,204 @@
+package pageable
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+)
+
+// Media types of the hypermedia representations.
+const (
+	// HALMediaType is the Content-Type of documents from MarshalHALPage and MarshalHALCursorPage.
+	HALMediaType = "application/hal+json"
+	// HydraMediaType is the Content-Type of documents from MarshalHydraPage and MarshalHydraCursorPage.
+	HydraMediaType = "application/ld+json"
+)
+
+// hydraContext is the JSON-LD context of Hydra collections.
+const hydraContext = "http://www.w3.org/ns/hydra/context.jsonld"
+
+// pageLinks holds the navigation hrefs of a page. Empty hrefs are omitted.
+type pageLinks struct {
+	self, first, prev, next, last string
+}
+
+// newPageLinks builds links for an offset page from the request URL u and req.
+func newPageLinks(u *url.URL, req PageRequest, m PageMetadata) pageLinks {
+	first, last := m.firstPage(), m.lastPage()
+	links := pageLinks{
+		self:  linkHref(u, req.PageLink(m.Page)),
+		first: linkHref(u, req.PageLink(first)),
+	}
+	if m.Page > first {
+		links.prev = linkHref(u, req.PageLink(min(m.Page-1, max(last, first))))
+	}
+	if m.Page < last {
+		links.next = linkHref(u, req.PageLink(m.Page+1))
+	}
+	if m.TotalPages > 0 {
+		links.last = linkHref(u, req.PageLink(last))
+	}
+	return links
+}
+
+// newCursorPageLinks builds links for a cursor page from the request URL u and req.
+// Cursor pages have no last link.
+func newCursorPageLinks(u *url.URL, req CursorRequest, m CursorPageMetadata) pageLinks {
+	links := pageLinks{
+		self:  linkHref(u, req.CursorLink(req.Cursor)),
+		first: linkHref(u, req.CursorLink("")),
+	}
+	if m.HasPrev && m.PrevCursor != "" {
+		links.prev = linkHref(u, req.CursorLink(m.PrevCursor))
+	}
+	if m.HasNext && m.NextCursor != "" {
+		links.next = linkHref(u, req.CursorLink(m.NextCursor))
+	}
+	return links
+}
+
+// linkHref returns u with its query replaced by values.
+func linkHref(u *url.URL, values url.Values) string {
+	link := *u
+	link.RawQuery = values.Encode()
+	link.Fragment = ""
+	return link.String()
+}
+
+// collectionHref returns u with the pagination parameters removed from values,
+// identifying the whole collection rather than one page.
+func collectionHref(u *url.URL, values url.Values) string {
+	values.Del(paramPage)
+	values.Del(paramCursor)
+	values.Del(paramSize)
+	return linkHref(u, values)
+}
+
+// MarshalHALPage renders p as a HAL document (HALMediaType): items under
+// _embedded[rel], self/first/prev/next/last under _links, and the metadata
+// as top-level properties. Links point at u, the originating request URL,
+// with query parameters from req.PageLink, so sorts, filters and fields carry over.
+func MarshalHALPage[T any](p Page[T], req PageRequest, u *url.URL, rel string) ([]byte, error) {
+	return marshalHAL(p.Items, p.Metadata, p.render, newPageLinks(u, req, p.Metadata), rel)
+}
+
+// MarshalHALCursorPage renders p as a HAL document like MarshalHALPage, with
+// links built from req.CursorLink. There is no last link.
+func MarshalHALCursorPage[T any](p CursorPage[T], req CursorRequest, u *url.URL, rel string) ([]byte, error) {
+	return marshalHAL(p.Items, p.Metadata, p.render, newCursorPageLinks(u, req, p.Metadata), rel)
+}
+
+// MarshalHydraPage renders p as a Hydra collection (HydraMediaType): items under
+// hydra:member, the total under hydra:totalItems, and a hydra:PartialCollectionView
+// with first/previous/next/last links built like MarshalHALPage.
+func MarshalHydraPage[T any](p Page[T], req PageRequest, u *url.URL) ([]byte, error) {
+	collection := collectionHref(u, req.PageLink(p.Metadata.Page))
+	return marshalHydra(p.Items, &p.Metadata.TotalItems, p.render, newPageLinks(u, req, p.Metadata), collection)
+}
+
+// MarshalHydraCursorPage renders p as a Hydra collection like MarshalHydraPage,
+// without hydra:totalItems, since cursor pages are not counted.
+func MarshalHydraCursorPage[T any](p CursorPage[T], req CursorRequest, u *url.URL) ([]byte, error) {
+	collection := collectionHref(u, req.CursorLink(""))
+	return marshalHydra(p.Items, nil, p.render, newCursorPageLinks(u, req, p.Metadata), collection)
+}
+
+// halLink is a HAL link object.
+type halLink struct {
+	Href string `json:"href"`
+}
+
+// marshalHAL encodes a HAL document.
+func marshalHAL[T any](items []T, metadata any, r pageRender, links pageLinks, rel string) ([]byte, error) {
+	if rel == "" {
+		rel = "items"
+	}
+	var halLinks []member
+	for _, l := range []struct{ rel, href string }{
+		{"self", links.self}, {"first", links.first}, {"prev", links.prev}, {"next", links.next}, {"last", links.last},
+	} {
+		if l.href == "" {
+			continue
+		}
+		b, err := marshalLink(halLink{Href: l.href})
+		if err != nil {
+			return nil, err
+		}
+		halLinks = append(halLinks, member{name: l.rel, value: b})
+	}
+
+	selected, err := selectItems(items, r.fields)
+	if err != nil {
+		return nil, err
+	}
+	embedded, err := json.Marshal(selected)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	properties, _, err := objectMembers(b)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := []member{
+		{name: "_links", value: writeObject(halLinks)},
+		{name: "_embedded", value: writeObject([]member{{name: rel, value: embedded}})},
+	}
+	return writeObject(append(doc, properties...)), nil
+}
+
+// hydraView is a hydra:PartialCollectionView.
+type hydraView struct {
+	ID       string `json:"@id"`
+	Type     string `json:"@type"`
+	First    string `json:"hydra:first,omitempty"`
+	Previous string `json:"hydra:previous,omitempty"`
+	Next     string `json:"hydra:next,omitempty"`
+	Last     string `json:"hydra:last,omitempty"`
+}
+
+// marshalHydra encodes a Hydra collection. totalItems is omitted when nil.
+func marshalHydra[T any](items []T, totalItems *int64, r pageRender, links pageLinks, collection string) ([]byte, error) {
+	selected, err := selectItems(items, r.fields)
+	if err != nil {
+		return nil, err
+	}
+	return marshalLink(struct {
+		Context    string    `json:"@context"`
+		ID         string    `json:"@id"`
+		Type       string    `json:"@type"`
+		TotalItems *int64    `json:"hydra:totalItems,omitempty"`
+		Member     any       `json:"hydra:member"`
+		View       hydraView `json:"hydra:view"`
+	}{
+		Context:    hydraContext,
+		ID:         collection,
+		Type:       "hydra:Collection",
+		TotalItems: totalItems,
+		Member:     selected,
+		View: hydraView{
+			ID:       links.self,
+			Type:     "hydra:PartialCollectionView",
+			First:    links.first,
+			Previous: links.prev,
+			Next:     links.next,
+			Last:     links.last,
+		},
+	})
+}
+
+// marshalLink encodes v as JSON without escaping HTML characters, so the "&"
+// in link query strings stays readable.
+func marshalLink(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
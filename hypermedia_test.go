@@ -0,0 +1,140 @@
+package pageable
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestMarshalHALPage(t *testing.T) {
+	u, _ := url.Parse("https://api.example.com/users?page=2&size=1&status=active")
+	req := PageRequestFromQuery(u.Query()).FilterableFields("status")
+	page := NewPage([]testItem{{ID: 2, Name: "Bob"}}, req, 3)
+
+	b, err := MarshalHALPage(page, req, u, "users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"_links":{` +
+		`"self":{"href":"https://api.example.com/users?page=2&size=1&status=active"},` +
+		`"first":{"href":"https://api.example.com/users?page=1&size=1&status=active"},` +
+		`"prev":{"href":"https://api.example.com/users?page=1&size=1&status=active"},` +
+		`"next":{"href":"https://api.example.com/users?page=3&size=1&status=active"},` +
+		`"last":{"href":"https://api.example.com/users?page=3&size=1&status=active"}},` +
+		`"_embedded":{"users":[{"id":2,"name":"Bob"}]},` +
+		`"page":2,"size":1,"totalItems":3,"totalPages":3}`
+	if string(b) != want {
+		t.Errorf("HAL =\n%s\nwant\n%s", b, want)
+	}
+}
+
+func TestMarshalHALPageMappedSorts(t *testing.T) {
+	build := func(values url.Values) PageRequest {
+		return PageRequestFromQuery(values).
+			SortableFields("createdAt").
+			MapSortFields(map[string]string{"createdAt": "created_at"}).
+			WithTieBreaker(Sort{Field: "id", Direction: ASC})
+	}
+	u, _ := url.Parse("/users?page=2&size=1&sort=createdAt,desc")
+	req := build(u.Query())
+	page := NewPage([]testItem{{ID: 2, Name: "Bob"}}, req, 3)
+
+	b, err := MarshalHALPage(page, req, u, "users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Links map[string]halLink `json:"_links"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	next := doc.Links["next"].Href
+	if want := "/users?page=3&size=1&sort=createdAt%2Cdesc"; next != want {
+		t.Errorf("next = %q, want %q", next, want)
+	}
+	link, _ := url.Parse(next)
+	if got, want := build(link.Query()).OrderBy(), req.OrderBy(); got != want {
+		t.Errorf("OrderBy() from next link = %q, want %q", got, want)
+	}
+}
+
+func TestMarshalHALCursorPage(t *testing.T) {
+	u, _ := url.Parse("/posts?cursor=abc&size=2")
+	req := CursorRequestFromQuery(u.Query())
+	page := NewCursorPage([]testItem{{ID: 1}}, "nxt", "", true, false, 2)
+
+	b, err := MarshalHALCursorPage(page, req, u, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Links    map[string]halLink         `json:"_links"`
+		Embedded map[string]json.RawMessage `json:"_embedded"`
+		HasNext  bool                       `json:"hasNext"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.Links["next"].Href; got != "/posts?cursor=nxt&size=2" {
+		t.Errorf("next = %q", got)
+	}
+	if got := doc.Links["first"].Href; got != "/posts?size=2" {
+		t.Errorf("first = %q", got)
+	}
+	if _, ok := doc.Links["prev"]; ok {
+		t.Error("unexpected prev link")
+	}
+	if _, ok := doc.Links["last"]; ok {
+		t.Error("unexpected last link")
+	}
+	if _, ok := doc.Embedded["items"]; !ok || !doc.HasNext {
+		t.Errorf("document = %s", b)
+	}
+}
+
+func TestMarshalHydraPage(t *testing.T) {
+	u, _ := url.Parse("/users?page=1&size=2")
+	req := PageRequestFromQuery(u.Query())
+	page := NewPage([]testItem{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}, req, 3).SelectFields("id")
+
+	b, err := MarshalHydraPage(page, req, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"@context":"http://www.w3.org/ns/hydra/context.jsonld","@id":"/users","@type":"hydra:Collection",` +
+		`"hydra:totalItems":3,"hydra:member":[{"id":1},{"id":2}],` +
+		`"hydra:view":{"@id":"/users?page=1&size=2","@type":"hydra:PartialCollectionView",` +
+		`"hydra:first":"/users?page=1&size=2","hydra:next":"/users?page=2&size=2","hydra:last":"/users?page=2&size=2"}}`
+	if string(b) != want {
+		t.Errorf("Hydra =\n%s\nwant\n%s", b, want)
+	}
+}
+
+func TestMarshalHydraCursorPage(t *testing.T) {
+	u, _ := url.Parse("/posts?cursor=abc&tag=go")
+	req := CursorRequestFromQuery(u.Query()).FilterableFields("tag")
+	page := NewCursorPage([]int{1}, "", "prv", false, true, 10)
+
+	b, err := MarshalHydraCursorPage(page, req, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc["hydra:totalItems"]; ok {
+		t.Error("unexpected hydra:totalItems")
+	}
+	if got := string(doc["@id"]); got != `"/posts?tag=go"` {
+		t.Errorf("@id = %s", got)
+	}
+	var view hydraView
+	if err := json.Unmarshal(doc["hydra:view"], &view); err != nil {
+		t.Fatal(err)
+	}
+	if view.Previous != "/posts?cursor=prv&size=10&tag=go" || view.Next != "" {
+		t.Errorf("view = %+v", view)
+	}
+}
@@ -0,0 +1,204 @@
+package pageable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// JSONPath declares a sort field stored inside a JSON document column.
+type JSONPath struct {
+	// Column is the JSON column, e.g. "attributes".
+	Column string
+	// Keys is the path of object keys inside the document, e.g. []string{"color"}.
+	Keys []string
+	// Cast optionally converts the extracted value for ordering, e.g. "numeric"
+	// or "DECIMAL(10,2)", so numbers do not sort as text.
+	Cast string
+}
+
+// JSONSortFields maps public sort aliases (e.g., "attributes.color") to
+// validated JSON paths. Build it once with NewJSONSortFields or
+// MustJSONSortFields, e.g. in a package-level var, and pass it to
+// MapJSONSortFields on every request. The zero value maps no aliases.
+type JSONSortFields struct {
+	paths map[string]JSONPath
+}
+
+// NewJSONSortFields validates the JSON paths declared for each sort alias.
+// The column must be a safe identifier, and Cast letters, digits, spaces and
+// "_(),". Keys may be any non-empty string without quotes or backslashes, as
+// they are embedded in SQL string literals (e.g., "shipping-date" is allowed).
+// Returns an error naming the first invalid alias.
+func NewJSONSortFields(paths map[string]JSONPath) (JSONSortFields, error) {
+	fields := JSONSortFields{paths: make(map[string]JSONPath, len(paths))}
+	for alias, p := range paths {
+		if err := p.validate(); err != nil {
+			return JSONSortFields{}, fmt.Errorf("pageable: invalid JSON sort field %q: %w", alias, err)
+		}
+		p.Keys = append([]string(nil), p.Keys...)
+		fields.paths[alias] = p
+	}
+	return fields, nil
+}
+
+// MustJSONSortFields is like NewJSONSortFields but panics if a path is invalid.
+// It is meant for package-level declarations, where an invalid path is a programming error.
+func MustJSONSortFields(paths map[string]JSONPath) JSONSortFields {
+	fields, err := NewJSONSortFields(paths)
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+// validate returns an error if the column, keys or cast are unsafe to embed in SQL.
+func (p JSONPath) validate() error {
+	if p.Column == "" || !isSafeIdentifier(p.Column) {
+		return fmt.Errorf("unsafe column %q", p.Column)
+	}
+	if len(p.Keys) == 0 {
+		return errors.New("no keys")
+	}
+	for _, k := range p.Keys {
+		if k == "" || strings.ContainsAny(k, `'"\`) {
+			return fmt.Errorf("unsafe key %q", k)
+		}
+	}
+	if !isSafeCastType(p.Cast) {
+		return fmt.Errorf("unsafe cast %q", p.Cast)
+	}
+	return nil
+}
+
+// sql renders the value extraction for dialect d:
+// attributes->>'color' (Postgres), JSON_EXTRACT(attributes, '$.color') (MySQL, SQLite),
+// or JSON_VALUE(attributes, '$.color') (ANSI), wrapped in CAST when Cast is set.
+func (p JSONPath) sql(d Dialect) string {
+	var expr string
+	switch d {
+	case Postgres:
+		expr = p.Column
+		for i, k := range p.Keys {
+			op := "->"
+			if i == len(p.Keys)-1 {
+				op = "->>"
+			}
+			expr += op + "'" + k + "'"
+		}
+	case MySQL, SQLite:
+		expr = "JSON_EXTRACT(" + p.Column + ", '" + p.pathExpr() + "')"
+	default:
+		expr = "JSON_VALUE(" + p.Column + ", '" + p.pathExpr() + "')"
+	}
+	if p.Cast != "" {
+		expr = "CAST(" + expr + " AS " + p.Cast + ")"
+	}
+	return expr
+}
+
+// pathExpr returns the SQL/JSON path of the keys, e.g. $.dims.size.
+// Keys that are not plain identifiers are double-quoted, e.g. $."shipping-date".
+func (p JSONPath) pathExpr() string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, k := range p.Keys {
+		b.WriteString(".")
+		if isSafeIdentifier(k) && !strings.Contains(k, ".") {
+			b.WriteString(k)
+		} else {
+			b.WriteString(`"` + k + `"`)
+		}
+	}
+	return b.String()
+}
+
+// lookup returns the value at the path inside item's JSON column, for in-memory sorting.
+// The column may hold a map, a struct, or encoded JSON ([]byte, json.RawMessage or string).
+// Returns an invalid Value, treated as null, when the path is missing.
+func (p JSONPath) lookup(item any) (reflect.Value, error) {
+	f, err := itemField(item, p.Column)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	v := f
+	for _, k := range p.Keys {
+		if v, err = jsonChild(v, k); err != nil {
+			return reflect.Value{}, fmt.Errorf("pageable: json path %s.%s: %w", p.Column, strings.Join(p.Keys, "."), err)
+		}
+		if !v.IsValid() {
+			break
+		}
+	}
+	return v, nil
+}
+
+// jsonChild returns the member key of a JSON object held in v.
+// Returns an invalid Value if the member or an intermediate value is missing or null.
+func jsonChild(v reflect.Value, key string) (reflect.Value, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, nil
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Kind() == reflect.Struct:
+		if f, ok := findField(v, key); ok {
+			return f, nil
+		}
+		return reflect.Value{}, nil
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		return v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())), nil
+	case v.Kind() == reflect.String:
+		return jsonDocChild([]byte(v.String()), key)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return jsonDocChild(v.Bytes(), key)
+	}
+	return reflect.Value{}, fmt.Errorf("cannot read key %q from %s", key, v.Type())
+}
+
+// jsonDocChild decodes an encoded JSON object and returns its member key.
+func jsonDocChild(b []byte, key string) (reflect.Value, error) {
+	if len(b) == 0 {
+		return reflect.Value{}, nil
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return reflect.Value{}, err
+	}
+	return jsonChild(reflect.ValueOf(doc), key)
+}
+
+// isSafeCastType checks that a cast type contains only letters, digits,
+// underscores, spaces, parentheses and commas (e.g., "numeric", "DECIMAL(10,2)").
+func isSafeCastType(s string) bool {
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("_ (),", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// mapJSONSortFields attaches the declared JSON path to each sort whose field is an alias.
+// Other sorts are kept as-is. The paths were validated by NewJSONSortFields.
+func mapJSONSortFields(sorts []Sort, fields JSONSortFields) []Sort {
+	if len(sorts) == 0 || len(fields.paths) == 0 {
+		return sorts
+	}
+	mapped := make([]Sort, len(sorts))
+	for i, s := range sorts {
+		if p, ok := fields.paths[s.Field]; ok {
+			s.JSONPath = &p
+		}
+		mapped[i] = s
+	}
+	return mapped
+}
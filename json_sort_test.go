@@ -0,0 +1,135 @@
+package pageable
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONSortFieldsOrderBy(t *testing.T) {
+	fields := MustJSONSortFields(map[string]JSONPath{
+		"attributes.color": {Column: "attributes", Keys: []string{"color"}},
+		"attributes.size":  {Column: "attributes", Keys: []string{"dims", "size"}, Cast: "numeric"},
+		"shipping.date":    {Column: "shipping", Keys: []string{"shipping-date"}},
+	})
+	sorts := []Sort{
+		{Field: "attributes.color", Direction: ASC},
+		{Field: "attributes.size", Direction: DESC},
+		{Field: "shipping.date", Direction: ASC},
+	}
+
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{Postgres, "attributes->>'color' asc, CAST(attributes->'dims'->>'size' AS numeric) desc, shipping->>'shipping-date' asc"},
+		{MySQL, "JSON_EXTRACT(attributes, '$.color') asc, CAST(JSON_EXTRACT(attributes, '$.dims.size') AS numeric) desc, " +
+			`JSON_EXTRACT(shipping, '$."shipping-date"') asc`},
+		{SQLite, "JSON_EXTRACT(attributes, '$.color') asc, CAST(JSON_EXTRACT(attributes, '$.dims.size') AS numeric) desc, " +
+			`JSON_EXTRACT(shipping, '$."shipping-date"') asc`},
+		{ANSI, "JSON_VALUE(attributes, '$.color') asc, CAST(JSON_VALUE(attributes, '$.dims.size') AS numeric) desc, " +
+			`JSON_VALUE(shipping, '$."shipping-date"') asc`},
+	}
+	for _, tt := range tests {
+		req := PageRequest{Sort: sorts}.MapJSONSortFields(fields).WithDialect(tt.dialect)
+		if got := req.OrderBy(); got != tt.expected {
+			t.Errorf("%q OrderBy() = %q, want %q", tt.dialect, got, tt.expected)
+		}
+	}
+}
+
+func TestNewJSONSortFieldsRejectsUnsafePaths(t *testing.T) {
+	tests := []struct {
+		name string
+		path JSONPath
+	}{
+		{"quoted key", JSONPath{Column: "attributes", Keys: []string{"x'; drop"}}},
+		{"double-quoted key", JSONPath{Column: "attributes", Keys: []string{`x"y`}}},
+		{"backslash key", JSONPath{Column: "attributes", Keys: []string{`x\y`}}},
+		{"empty key", JSONPath{Column: "attributes", Keys: []string{""}}},
+		{"unsafe column", JSONPath{Column: "attrs; drop", Keys: []string{"x"}}},
+		{"unsafe cast", JSONPath{Column: "attributes", Keys: []string{"x"}, Cast: "int; drop"}},
+		{"no keys", JSONPath{Column: "attributes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewJSONSortFields(map[string]JSONPath{"attributes.color": tt.path}); err == nil {
+				t.Error("expected error for invalid JSON path")
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustJSONSortFields should panic for an invalid JSON path")
+		}
+	}()
+	MustJSONSortFields(map[string]JSONPath{"attributes.color": tests[0].path})
+}
+
+func TestJSONSortFieldsKeyset(t *testing.T) {
+	req := CursorRequest{Size: 10, Sort: []Sort{
+		{Field: "attributes.color", Direction: ASC},
+		{Field: "id", Direction: ASC},
+	}}.MapJSONSortFields(MustJSONSortFields(map[string]JSONPath{
+		"attributes.color": {Column: "attributes", Keys: []string{"color"}},
+	})).WithDialect(Postgres)
+	req.Cursor, _ = EncodeCursor(CursorData{Keys: CursorKeys{
+		{Field: "attributes.color", Value: "red"},
+		{Field: "id", Value: int64(3)},
+	}})
+
+	ks, err := req.Keyset()
+	if err != nil {
+		t.Fatalf("Keyset error: %v", err)
+	}
+	want := "(((attributes->>'color' > ? OR attributes->>'color' IS NULL)) OR " +
+		"(attributes->>'color' = ? AND (id > ? OR id IS NULL)))"
+	if ks.Where != want {
+		t.Errorf("Where = %q, want %q", ks.Where, want)
+	}
+}
+
+type jsonSortRow struct {
+	ID    int             `json:"id"`
+	Attrs map[string]any  `json:"attributes"`
+	Raw   json.RawMessage `json:"raw"`
+}
+
+func TestJSONSortFieldsInMemory(t *testing.T) {
+	rows := []jsonSortRow{
+		{ID: 1, Attrs: map[string]any{"color": "red", "dims": map[string]any{"size": 3.0}}, Raw: json.RawMessage(`{"rank": 2}`)},
+		{ID: 2, Attrs: map[string]any{"color": "blue", "dims": map[string]any{"size": 10.0}}, Raw: json.RawMessage(`{"rank": 1}`)},
+		{ID: 3, Attrs: map[string]any{}, Raw: json.RawMessage(`{}`)},
+	}
+	fields := MustJSONSortFields(map[string]JSONPath{
+		"color": {Column: "attributes", Keys: []string{"color"}},
+		"size":  {Column: "attributes", Keys: []string{"dims", "size"}},
+		"rank":  {Column: "raw", Keys: []string{"rank"}},
+	})
+
+	tests := []struct {
+		sort Sort
+		want []int
+	}{
+		{Sort{Field: "color", Direction: ASC}, []int{3, 2, 1}},
+		{Sort{Field: "size", Direction: DESC}, []int{2, 1, 3}},
+		{Sort{Field: "rank", Direction: ASC, Nulls: NullsLast}, []int{2, 1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sort.Field, func(t *testing.T) {
+			req := CursorRequest{Size: 10, Sort: []Sort{tt.sort}}.MapJSONSortFields(fields)
+			page, err := PaginateSlice(rows, req)
+			if err != nil {
+				t.Fatalf("PaginateSlice error: %v", err)
+			}
+			ids := make([]int, len(page.Items))
+			for i, r := range page.Items {
+				ids[i] = r.ID
+			}
+			if !equalInts(ids, tt.want) {
+				t.Errorf("order = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package pageable
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Keyset holds the SQL fragments for a keyset-paginated query.
+// Placeholders are "?" and Args are listed in the order they appear in
+// Where followed by OrderBy.
+type Keyset struct {
+	// Where is the condition selecting rows past the cursor, to be ANDed into
+	// the query's WHERE clause. Empty when there is no cursor (first page).
+	Where string
+	// OrderBy is the ORDER BY clause for the query. Sorts are reversed when
+	// paginating backward or from the head, so the rows nearest the cursor come first.
+	OrderBy string
+	// Args are the bind arguments for Where and OrderBy.
+	Args []any
+	// Reverse is true when the fetched rows must be reversed to restore
+	// display order (backward pagination).
+	Reverse bool
+}
+
+// newKeyset builds the keyset query fragments for sorts and decoded cursor data.
+// Each sort field, including any tie-breaker, must have a matching key in data.Keys.
+func newKeyset(sorts []Sort, data CursorData, d Dialect) (Keyset, error) {
+	if len(data.Keys) == 0 {
+		ob, args := orderBy(sorts, d)
+		return Keyset{OrderBy: ob, Args: args}, nil
+	}
+	if len(sorts) == 0 {
+		return Keyset{}, errors.New("pageable: keyset pagination requires at least one sort")
+	}
+
+	var ks Keyset
+	switch data.Direction {
+	case Prev:
+		sorts = reverseSorts(sorts)
+		ks.Reverse = true
+	case Head:
+		sorts = reverseSorts(sorts)
+	}
+
+	where, args, err := keysetPredicate(sorts, data.Keys, d)
+	if err != nil {
+		return Keyset{}, err
+	}
+	ob, obArgs := orderBy(sorts, d)
+	args = append(args, obArgs...)
+	ks.Where, ks.OrderBy, ks.Args = where, ob, args
+	return ks, nil
+}
+
+// keysetPredicate renders the condition selecting rows strictly after keys in
+// the order given by sorts, expanded as
+// (a > ?) OR (a = ? AND b > ?) OR (a = ? AND b = ? AND c > ?).
+// Null keys and sorts with a known null placement, explicit or the dialect's
+// default, use IS NULL comparisons, so rows with null sort values are neither
+// skipped nor repeated. Case-insensitive,
+// collated and expression sorts compare with the same expression used by ORDER BY.
+func keysetPredicate(sorts []Sort, keys CursorKeys, d Dialect) (string, []any, error) {
+	var (
+		args   []any
+		terms  []string
+		prefix []string
+		pargs  []any
+	)
+	for _, s := range sorts {
+		v, ok := keys.Get(s.Field)
+		if !ok {
+			return "", nil, fmt.Errorf("pageable: cursor has no key for sort field %q", s.Field)
+		}
+
+		after, aargs, ok, err := keysetAfter(s, v, d)
+		if err != nil {
+			return "", nil, err
+		}
+		if ok {
+			conds := append(append([]string{}, prefix...), after)
+			terms = append(terms, "("+strings.Join(conds, " AND ")+")")
+			args = append(append(args, pargs...), aargs...)
+		}
+
+		if v == nil {
+			col, colArgs := sortColumn(s, d)
+			prefix = append(prefix, col+" IS NULL")
+			pargs = append(pargs, colArgs...)
+		} else {
+			expr, exprArgs, ph := sortExpr(s, d)
+			prefix = append(prefix, expr+" = "+ph)
+			pargs = append(append(pargs, exprArgs...), v)
+		}
+	}
+	if len(terms) == 0 {
+		return "(1 = 0)", nil, nil
+	}
+	return "(" + strings.Join(terms, " OR ") + ")", args, nil
+}
+
+// keysetAfter renders the condition for values of s that come strictly after v.
+// Returns ok == false when no value can follow v (a null placed last).
+// Without an explicit null ordering, the dialect's default placement is used,
+// so rows with null sort values are not skipped. Only for ANSI, where the
+// placement is unknown, is a non-null v compared as if the column were not nullable.
+func keysetAfter(s Sort, v any, d Dialect) (cond string, args []any, ok bool, err error) {
+	col, colArgs := sortColumn(s, d)
+	nulls := s.Nulls
+	if nulls == NullsDefault {
+		nulls = d.defaultNulls(s.Direction)
+	}
+	if v == nil {
+		switch nulls {
+		case NullsFirst:
+			return col + " IS NOT NULL", colArgs, true, nil
+		case NullsLast:
+			return "", nil, false, nil
+		}
+		return "", nil, false, fmt.Errorf("pageable: cursor key %q is null but its sort has no null ordering", s.Field)
+	}
+
+	expr, exprArgs, ph := sortExpr(s, d)
+	op := " > "
+	if s.Direction == DESC {
+		op = " < "
+	}
+	cond = expr + op + ph
+	args = append(append(args, exprArgs...), v)
+	if nulls == NullsLast {
+		cond = "(" + cond + " OR " + col + " IS NULL)"
+		args = append(args, colArgs...)
+	}
+	return cond, args, true, nil
+}
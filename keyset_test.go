@@ -0,0 +1,287 @@
+package pageable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeysetPredicate(t *testing.T) {
+	tests := []struct {
+		name      string
+		sorts     []Sort
+		data      CursorData
+		wantWhere string
+		wantOrder string
+		wantArgs  []any
+		wantRev   bool
+	}{
+		{
+			name:      "no cursor",
+			sorts:     []Sort{{Field: "id", Direction: ASC}},
+			wantOrder: "id asc",
+		},
+		{
+			name:      "single key",
+			sorts:     []Sort{{Field: "id", Direction: ASC}},
+			data:      CursorData{Direction: Next, Keys: CursorKeys{{Field: "id", Value: int64(42)}}},
+			wantWhere: "((id > ?))",
+			wantOrder: "id asc",
+			wantArgs:  []any{int64(42)},
+		},
+		{
+			name: "compound key with tie-breaker",
+			sorts: []Sort{
+				{Field: "name", Direction: DESC},
+				{Field: "id", Direction: ASC},
+			},
+			data: CursorData{Direction: Next, Keys: CursorKeys{
+				{Field: "name", Value: "bob"},
+				{Field: "id", Value: int64(7)},
+			}},
+			wantWhere: "((name < ?) OR (name = ? AND id > ?))",
+			wantOrder: "name desc, id asc",
+			wantArgs:  []any{"bob", "bob", int64(7)},
+		},
+		{
+			name: "prev reverses",
+			sorts: []Sort{
+				{Field: "name", Direction: DESC},
+				{Field: "id", Direction: ASC},
+			},
+			data: CursorData{Direction: Prev, Keys: CursorKeys{
+				{Field: "id", Value: int64(7)},
+				{Field: "name", Value: "bob"},
+			}},
+			wantWhere: "((name > ?) OR (name = ? AND id < ?))",
+			wantOrder: "name asc, id desc",
+			wantArgs:  []any{"bob", "bob", int64(7)},
+			wantRev:   true,
+		},
+		{
+			name: "head reverses without restoring order",
+			sorts: []Sort{
+				{Field: "created_at", Direction: DESC},
+				{Field: "id", Direction: DESC},
+			},
+			data: CursorData{Direction: Head, Keys: CursorKeys{
+				{Field: "created_at", Value: "2024-01-01"},
+				{Field: "id", Value: int64(7)},
+			}},
+			wantWhere: "((created_at > ?) OR (created_at = ? AND id > ?))",
+			wantOrder: "created_at asc, id asc",
+			wantArgs:  []any{"2024-01-01", "2024-01-01", int64(7)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := newKeyset(tt.sorts, tt.data, ANSI)
+			if err != nil {
+				t.Fatalf("newKeyset error: %v", err)
+			}
+			if ks.Where != tt.wantWhere {
+				t.Errorf("Where = %q, want %q", ks.Where, tt.wantWhere)
+			}
+			if ks.OrderBy != tt.wantOrder {
+				t.Errorf("OrderBy = %q, want %q", ks.OrderBy, tt.wantOrder)
+			}
+			if !reflect.DeepEqual(ks.Args, tt.wantArgs) {
+				t.Errorf("Args = %v, want %v", ks.Args, tt.wantArgs)
+			}
+			if ks.Reverse != tt.wantRev {
+				t.Errorf("Reverse = %v, want %v", ks.Reverse, tt.wantRev)
+			}
+		})
+	}
+}
+
+func TestKeysetErrors(t *testing.T) {
+	data := CursorData{Keys: CursorKeys{{Field: "id", Value: int64(1)}}}
+	if _, err := newKeyset(nil, data, ANSI); err == nil {
+		t.Error("expected error when sorts are empty")
+	}
+
+	sorts := []Sort{{Field: "name", Direction: ASC}, {Field: "id", Direction: ASC}}
+	if _, err := newKeyset(sorts, data, ANSI); err == nil {
+		t.Error("expected error when a sort field has no key")
+	}
+}
+
+func TestCursorRequestKeyset(t *testing.T) {
+	row := cursorTestRow{cursorTestBase: cursorTestBase{ID: 9}, Title: "Hello"}
+	req := CursorRequest{Size: 10, Sort: []Sort{{Field: "title", Direction: ASC}}}.
+		WithTieBreaker(Sort{Field: "id", Direction: ASC})
+
+	data, err := CursorFromItem(row, req.Sort)
+	if err != nil {
+		t.Fatalf("CursorFromItem error: %v", err)
+	}
+	req.Cursor, _ = EncodeCursor(data)
+
+	ks, err := req.Keyset()
+	if err != nil {
+		t.Fatalf("Keyset error: %v", err)
+	}
+	if want := "((title > ?) OR (title = ? AND id > ?))"; ks.Where != want {
+		t.Errorf("Where = %q, want %q", ks.Where, want)
+	}
+	if want := []any{"Hello", "Hello", int64(9)}; !reflect.DeepEqual(ks.Args, want) {
+		t.Errorf("Args = %v, want %v", ks.Args, want)
+	}
+
+	req.Cursor = "!!!"
+	if _, err := req.Keyset(); err == nil {
+		t.Error("expected error for invalid cursor")
+	}
+}
+
+func TestKeysetNulls(t *testing.T) {
+	tests := []struct {
+		name      string
+		sort      Sort
+		value     any
+		dialect   Dialect
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:      "value with nulls last",
+			sort:      Sort{Field: "due", Direction: DESC, Nulls: NullsLast},
+			value:     "2024-01-01",
+			wantWhere: "(((due < ? OR due IS NULL)) OR (due = ? AND id > ?))",
+			wantArgs:  []any{"2024-01-01", "2024-01-01", int64(7)},
+		},
+		{
+			name:      "value with nulls first",
+			sort:      Sort{Field: "due", Direction: ASC, Nulls: NullsFirst},
+			value:     "2024-01-01",
+			wantWhere: "((due > ?) OR (due = ? AND id > ?))",
+			wantArgs:  []any{"2024-01-01", "2024-01-01", int64(7)},
+		},
+		{
+			name:      "null with nulls last",
+			sort:      Sort{Field: "due", Direction: ASC, Nulls: NullsLast},
+			wantWhere: "((due IS NULL AND id > ?))",
+			wantArgs:  []any{int64(7)},
+		},
+		{
+			name:      "null with nulls first",
+			sort:      Sort{Field: "due", Direction: ASC, Nulls: NullsFirst},
+			wantWhere: "((due IS NOT NULL) OR (due IS NULL AND id > ?))",
+			wantArgs:  []any{int64(7)},
+		},
+		{
+			name:      "null with postgres default ordering",
+			sort:      Sort{Field: "due", Direction: DESC},
+			dialect:   Postgres,
+			wantWhere: "((due IS NOT NULL) OR (due IS NULL AND (id > ? OR id IS NULL)))",
+			wantArgs:  []any{int64(7)},
+		},
+		{
+			name:      "value with postgres default ordering",
+			sort:      Sort{Field: "due", Direction: ASC},
+			value:     "2024-01-01",
+			dialect:   Postgres,
+			wantWhere: "(((due > ? OR due IS NULL)) OR (due = ? AND (id > ? OR id IS NULL)))",
+			wantArgs:  []any{"2024-01-01", "2024-01-01", int64(7)},
+		},
+		{
+			name:      "value with mysql default ordering",
+			sort:      Sort{Field: "due", Direction: DESC},
+			value:     "2024-01-01",
+			dialect:   MySQL,
+			wantWhere: "(((due < ? OR due IS NULL)) OR (due = ? AND id > ?))",
+			wantArgs:  []any{"2024-01-01", "2024-01-01", int64(7)},
+		},
+		{
+			name:      "value with ansi default ordering",
+			sort:      Sort{Field: "due", Direction: ASC},
+			value:     "2024-01-01",
+			wantWhere: "((due > ?) OR (due = ? AND id > ?))",
+			wantArgs:  []any{"2024-01-01", "2024-01-01", int64(7)},
+		},
+		{
+			name:      "null with mysql default ordering",
+			sort:      Sort{Field: "due", Direction: DESC},
+			dialect:   MySQL,
+			wantWhere: "((due IS NULL AND id > ?))",
+			wantArgs:  []any{int64(7)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorts := []Sort{tt.sort, {Field: "id", Direction: ASC}}
+			data := CursorData{Keys: CursorKeys{{Field: "due", Value: tt.value}, {Field: "id", Value: int64(7)}}}
+			ks, err := newKeyset(sorts, data, tt.dialect)
+			if err != nil {
+				t.Fatalf("newKeyset error: %v", err)
+			}
+			if ks.Where != tt.wantWhere {
+				t.Errorf("Where = %q, want %q", ks.Where, tt.wantWhere)
+			}
+			if !reflect.DeepEqual(ks.Args, tt.wantArgs) {
+				t.Errorf("Args = %v, want %v", ks.Args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestKeysetNullsNoRowsAfter(t *testing.T) {
+	sorts := []Sort{{Field: "due", Direction: ASC, Nulls: NullsLast}}
+	data := CursorData{Keys: CursorKeys{{Field: "due", Value: nil}}}
+	ks, err := newKeyset(sorts, data, ANSI)
+	if err != nil {
+		t.Fatalf("newKeyset error: %v", err)
+	}
+	if ks.Where != "(1 = 0)" {
+		t.Errorf("Where = %q, want %q", ks.Where, "(1 = 0)")
+	}
+
+	// Null key with unknown null placement cannot be compared.
+	sorts = []Sort{{Field: "due", Direction: ASC}}
+	if _, err := newKeyset(sorts, data, ANSI); err == nil {
+		t.Error("expected error for null key without null ordering")
+	}
+}
+
+func TestKeysetPrevFlipsNulls(t *testing.T) {
+	sorts := []Sort{{Field: "due", Direction: ASC, Nulls: NullsLast}}
+	data := CursorData{Direction: Prev, Keys: CursorKeys{{Field: "due", Value: "x"}}}
+	ks, err := newKeyset(sorts, data, Postgres)
+	if err != nil {
+		t.Fatalf("newKeyset error: %v", err)
+	}
+	if want := "((due < ?))"; ks.Where != want {
+		t.Errorf("Where = %q, want %q", ks.Where, want)
+	}
+	if want := "due desc nulls first"; ks.OrderBy != want {
+		t.Errorf("OrderBy = %q, want %q", ks.OrderBy, want)
+	}
+}
+
+func TestKeysetCaseInsensitive(t *testing.T) {
+	sorts := []Sort{{Field: "name", Direction: ASC, CaseInsensitive: true}, {Field: "id", Direction: ASC}}
+	data := CursorData{Keys: CursorKeys{{Field: "name", Value: "Bob"}, {Field: "id", Value: int64(7)}}}
+
+	tests := []struct {
+		dialect   Dialect
+		wantWhere string
+		wantOrder string
+	}{
+		{Postgres, "(((LOWER(name) > LOWER(?) OR name IS NULL)) OR (LOWER(name) = LOWER(?) AND (id > ? OR id IS NULL)))", "LOWER(name) asc, id asc"},
+		{SQLite, "((name COLLATE NOCASE > ?) OR (name COLLATE NOCASE = ? AND id > ?))", "name COLLATE NOCASE asc, id asc"},
+	}
+	for _, tt := range tests {
+		ks, err := newKeyset(sorts, data, tt.dialect)
+		if err != nil {
+			t.Fatalf("newKeyset error: %v", err)
+		}
+		if ks.Where != tt.wantWhere {
+			t.Errorf("%q Where = %q, want %q", tt.dialect, ks.Where, tt.wantWhere)
+		}
+		if ks.OrderBy != tt.wantOrder {
+			t.Errorf("%q OrderBy = %q, want %q", tt.dialect, ks.OrderBy, tt.wantOrder)
+		}
+	}
+}
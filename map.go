@@ -0,0 +1,143 @@
+package pageable
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// MapPage converts the items of p with fn, keeping the metadata and rendering options.
+// Use it to turn database rows into response DTOs.
+func MapPage[T, U any](p Page[T], fn func(T) U) Page[U] {
+	return Page[U]{Items: mapItems(p.Items, fn), Metadata: p.Metadata, render: p.render}
+}
+
+// MapPageErr is like MapPage for conversions that can fail.
+// It stops at the first error and returns it.
+func MapPageErr[T, U any](p Page[T], fn func(T) (U, error)) (Page[U], error) {
+	items, err := mapItemsErr(p.Items, fn)
+	if err != nil {
+		return Page[U]{}, err
+	}
+	return Page[U]{Items: items, Metadata: p.Metadata, render: p.render}, nil
+}
+
+// MapPageConcurrent is like MapPageErr, calling fn for up to limit items at a
+// time, for costly per-item work such as enrichment from another service.
+// Item order is preserved. A limit below 1 uses runtime.GOMAXPROCS(0).
+// On the first error, or when ctx is done, the context passed to fn is
+// canceled, no further items are started and the error is returned.
+func MapPageConcurrent[T, U any](ctx context.Context, p Page[T], limit int, fn func(context.Context, T) (U, error)) (Page[U], error) {
+	items, err := mapItemsConcurrent(ctx, p.Items, limit, fn)
+	if err != nil {
+		return Page[U]{}, err
+	}
+	return Page[U]{Items: items, Metadata: p.Metadata, render: p.render}, nil
+}
+
+// MapCursorPage converts the items of p with fn, keeping the metadata and rendering options.
+// Use it to turn database rows into response DTOs.
+func MapCursorPage[T, U any](p CursorPage[T], fn func(T) U) CursorPage[U] {
+	return CursorPage[U]{Items: mapItems(p.Items, fn), Metadata: p.Metadata, render: p.render}
+}
+
+// MapCursorPageErr is like MapCursorPage for conversions that can fail.
+// It stops at the first error and returns it.
+func MapCursorPageErr[T, U any](p CursorPage[T], fn func(T) (U, error)) (CursorPage[U], error) {
+	items, err := mapItemsErr(p.Items, fn)
+	if err != nil {
+		return CursorPage[U]{}, err
+	}
+	return CursorPage[U]{Items: items, Metadata: p.Metadata, render: p.render}, nil
+}
+
+// MapCursorPageConcurrent is like MapCursorPageErr, calling fn for up to limit
+// items at a time, with the same ordering and cancellation as MapPageConcurrent.
+func MapCursorPageConcurrent[T, U any](
+	ctx context.Context, p CursorPage[T], limit int, fn func(context.Context, T) (U, error),
+) (CursorPage[U], error) {
+	items, err := mapItemsConcurrent(ctx, p.Items, limit, fn)
+	if err != nil {
+		return CursorPage[U]{}, err
+	}
+	return CursorPage[U]{Items: items, Metadata: p.Metadata, render: p.render}, nil
+}
+
+// mapItems applies fn to every item. A nil slice yields an empty one.
+func mapItems[T, U any](items []T, fn func(T) U) []U {
+	out := make([]U, len(items))
+	for i, item := range items {
+		out[i] = fn(item)
+	}
+	return out
+}
+
+// mapItemsErr applies fn to every item, stopping at the first error.
+func mapItemsErr[T, U any](items []T, fn func(T) (U, error)) ([]U, error) {
+	out := make([]U, len(items))
+	for i, item := range items {
+		u, err := fn(item)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = u
+	}
+	return out, nil
+}
+
+// mapItemsConcurrent applies fn to every item with at most limit workers,
+// writing each result at its item's index.
+func mapItemsConcurrent[T, U any](ctx context.Context, items []T, limit int, fn func(context.Context, T) (U, error)) ([]U, error) {
+	if limit < 1 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	out := make([]U, len(items))
+	next := make(chan int)
+	for w := 0; w < min(limit, len(items)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if ctx.Err() != nil {
+					continue
+				}
+				u, err := fn(ctx, items[i])
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				out[i] = u
+			}
+		}()
+	}
+
+feed:
+	for i := range items {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,121 @@
+package pageable
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapPage(t *testing.T) {
+	page := NewPage([]testItem{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}, PageRequest{Page: 2, Size: 2}, 5)
+	got := MapPage(page, func(it testItem) string { return it.Name })
+
+	if len(got.Items) != 2 || got.Items[0] != "a" || got.Items[1] != "b" {
+		t.Errorf("Items = %v, want [a b]", got.Items)
+	}
+	if got.Metadata != page.Metadata {
+		t.Errorf("Metadata = %+v, want %+v", got.Metadata, page.Metadata)
+	}
+
+	empty := MapPage(EmptyPage[testItem](PageRequest{Page: 1, Size: 10}), func(it testItem) int { return it.ID })
+	if empty.Items == nil {
+		t.Error("Items = nil, want empty slice")
+	}
+}
+
+func TestMapPageErr(t *testing.T) {
+	page := NewPage([]string{"1", "x", "3"}, PageRequest{Page: 1, Size: 3}, 3)
+	if _, err := MapPageErr(page, strconv.Atoi); err == nil {
+		t.Error("expected error for invalid item")
+	}
+
+	page.Items = []string{"1", "2"}
+	got, err := MapPageErr(page, strconv.Atoi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Items) != 2 || got.Items[1] != 2 {
+		t.Errorf("Items = %v, want [1 2]", got.Items)
+	}
+}
+
+func TestMapCursorPage(t *testing.T) {
+	page := NewCursorPage([]int{1, 2, 3}, "next", "prev", true, true, 3)
+	got := MapCursorPage(page, func(i int) int { return i * 10 })
+	if len(got.Items) != 3 || got.Items[2] != 30 {
+		t.Errorf("Items = %v, want [10 20 30]", got.Items)
+	}
+	if got.Metadata != page.Metadata {
+		t.Errorf("Metadata = %+v, want %+v", got.Metadata, page.Metadata)
+	}
+
+	if _, err := MapCursorPageErr(page, func(i int) (int, error) {
+		return 0, errors.New("boom")
+	}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMapPageConcurrent(t *testing.T) {
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+	page := NewPage(items, PageRequest{Page: 1, Size: 50}, 50)
+
+	var running, peak atomic.Int32
+	got, err := MapPageConcurrent(context.Background(), page, 4, func(_ context.Context, i int) (int, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * time.Duration(50-i) / 10)
+		return i * 2, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range got.Items {
+		if v != i*2 {
+			t.Fatalf("Items[%d] = %d, want %d", i, v, i*2)
+		}
+	}
+	if p := peak.Load(); p > 4 {
+		t.Errorf("peak concurrency = %d, want <= 4", p)
+	}
+}
+
+func TestMapCursorPageConcurrentError(t *testing.T) {
+	page := NewCursorPage([]int{1, 2, 3, 4, 5, 6, 7, 8}, "", "", false, false, 8)
+	boom := errors.New("boom")
+
+	var calls atomic.Int32
+	_, err := MapCursorPageConcurrent(context.Background(), page, 1, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i == 2 {
+			return 0, boom
+		}
+		return i, ctx.Err()
+	})
+	if !errors.Is(err, boom) {
+		t.Errorf("err = %v, want boom", err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("fn called %d times, want 2", n)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := MapCursorPageConcurrent(ctx, page, 2, func(_ context.Context, i int) (int, error) {
+		return i, nil
+	}); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
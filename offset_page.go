@@ -0,0 +1,61 @@
+package pageable
+
+// OffsetPageMetadata holds pagination metadata for offset/limit pagination.
+type OffsetPageMetadata struct {
+	Offset     int   `json:"offset"`
+	Limit      int   `json:"limit"`
+	TotalItems int64 `json:"totalItems"`
+	HasMore    bool  `json:"hasMore"`
+}
+
+// OffsetPage represents a paginated response for OffsetRequest.
+type OffsetPage[T any] struct {
+	Items    []T                `json:"items"`
+	Metadata OffsetPageMetadata `json:"metadata"`
+
+	render pageRender
+}
+
+// EmptyOffsetPage creates an empty OffsetPage with zero results, preserving the request's offset and limit.
+func EmptyOffsetPage[T any](request OffsetRequest) OffsetPage[T] {
+	return NewOffsetPage[T](nil, request, 0)
+}
+
+// NewOffsetPage creates an OffsetPage from items, request parameters, and total item count.
+// HasMore is true when items remain after this page.
+// A nil items slice is converted to an empty slice to ensure JSON serializes as [] not null.
+func NewOffsetPage[T any](items []T, request OffsetRequest, totalItems int64) OffsetPage[T] {
+	if items == nil {
+		items = make([]T, 0)
+	}
+	return OffsetPage[T]{
+		Items: items,
+		Metadata: OffsetPageMetadata{
+			Offset:     request.Offset,
+			Limit:      request.Limit,
+			TotalItems: totalItems,
+			HasMore:    int64(request.Offset)+int64(len(items)) < totalItems,
+		},
+	}
+}
+
+// SelectFields returns the page with items marshaled to JSON with only the
+// given top-level fields (e.g., the request's Fields), named as in the items'
+// JSON encoding. The envelope is unchanged. No fields marshals items whole.
+func (p OffsetPage[T]) SelectFields(fields ...string) OffsetPage[T] {
+	p.render.fields = fields
+	return p
+}
+
+// WithEnvelope returns the page marshaled to JSON with the key names and
+// shape of e instead of the default "items"/"metadata" envelope.
+func (p OffsetPage[T]) WithEnvelope(e Envelope) OffsetPage[T] {
+	p.render.envelope = &e
+	return p
+}
+
+// MarshalJSON implements json.Marshaler, applying the fields set by
+// SelectFields and the envelope set by WithEnvelope.
+func (p OffsetPage[T]) MarshalJSON() ([]byte, error) {
+	return marshalPage(p.Items, p.Metadata, p.render)
+}
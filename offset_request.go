@@ -0,0 +1,203 @@
+package pageable
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// OffsetRequest represents raw offset/limit pagination parameters, for
+// clients that page by item position (?offset=37&limit=10) rather than page number.
+type OffsetRequest struct {
+	Offset  int
+	Limit   int
+	Sort    []Sort
+	Filters []Filter
+	Fields  []string
+
+	requestState
+}
+
+// NewOffsetRequest creates an OffsetRequest with defaults applied.
+// Offset is clamped to a minimum of 0. Limit is clamped to [1, MaxSize].
+func NewOffsetRequest(offset, limit int, sort []Sort) OffsetRequest {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit < 1 {
+		limit = DefaultSize
+	}
+	if limit > MaxSize {
+		limit = MaxSize
+	}
+	return OffsetRequest{Offset: offset, Limit: limit, Sort: sort}
+}
+
+// OffsetRequestFromQuery parses an OffsetRequest from URL query parameters.
+// Recognized keys: "offset", "limit", "sort", "fields"; all other keys are
+// parsed as filters (see ParseFilters), applied once allowed by FilterableFields. Uses 0 and DefaultSize for missing or
+// invalid values. Limit is clamped to [1, MaxSize]. Options change how sorts are read.
+func OffsetRequestFromQuery(values url.Values, opts ...QueryOption) OffsetRequest {
+	query := newQueryConfig(opts)
+
+	offset := 0
+	if v := values.Get(paramOffset); v != "" {
+		if o, err := strconv.Atoi(v); err == nil && o > 0 {
+			offset = o
+		}
+	}
+
+	limit := DefaultSize
+	if v := values.Get(paramLimit); v != "" {
+		if l, err := strconv.Atoi(v); err == nil && l > 0 {
+			limit = l
+		}
+	}
+	if limit > MaxSize {
+		limit = MaxSize
+	}
+
+	return OffsetRequest{
+		Offset:       offset,
+		Limit:        limit,
+		Sort:         query.parseSorts(values),
+		requestState: newRequestState(values, query),
+	}
+}
+
+// SortableFields works like PageRequest.SortableFields.
+func (or OffsetRequest) SortableFields(fields ...string) OffsetRequest {
+	or.Sort = filterSortsByFields(or.Sort, fields...)
+	return or
+}
+
+// ApplySortPolicy works like PageRequest.ApplySortPolicy.
+func (or OffsetRequest) ApplySortPolicy(policy SortPolicy) (OffsetRequest, error) {
+	sorts, err := policy.Apply(or.Sort)
+	if err != nil {
+		return or, err
+	}
+	or.Sort = sorts
+	return or, nil
+}
+
+// MapSortFields works like PageRequest.MapSortFields.
+func (or OffsetRequest) MapSortFields(fieldMap map[string]string) OffsetRequest {
+	or.Sort = or.mapSortFields(or.Sort, fieldMap)
+	return or
+}
+
+// MapJSONSortFields works like PageRequest.MapJSONSortFields.
+func (or OffsetRequest) MapJSONSortFields(fields JSONSortFields) OffsetRequest {
+	or.Sort = or.mapJSONSortFields(or.Sort, fields)
+	return or
+}
+
+// WithDefaultSort works like PageRequest.WithDefaultSort.
+func (or OffsetRequest) WithDefaultSort(sorts ...Sort) OffsetRequest {
+	if or.Sort == nil {
+		or.Sort = sorts
+	}
+	return or
+}
+
+// WithTieBreaker works like PageRequest.WithTieBreaker.
+func (or OffsetRequest) WithTieBreaker(tie Sort) OffsetRequest {
+	or.Sort = or.appendTieBreaker(or.Sort, tie)
+	return or
+}
+
+// WithDialect works like PageRequest.WithDialect.
+func (or OffsetRequest) WithDialect(d Dialect) OffsetRequest {
+	or.dialect = d
+	return or
+}
+
+// OrderBy works like PageRequest.OrderBy.
+func (or OffsetRequest) OrderBy() string {
+	ob, _ := or.orderBy(or.Sort)
+	return ob
+}
+
+// OrderByArgs works like PageRequest.OrderByArgs.
+func (or OffsetRequest) OrderByArgs() []any {
+	_, args := or.orderBy(or.Sort)
+	return args
+}
+
+// MapSortExpressions works like PageRequest.MapSortExpressions.
+func (or OffsetRequest) MapSortExpressions(exprs SortExpressions) OffsetRequest {
+	or.Sort = or.mapSortExpressions(or.Sort, exprs)
+	return or
+}
+
+// FilterableFields works like PageRequest.FilterableFields.
+func (or OffsetRequest) FilterableFields(fields ...string) OffsetRequest {
+	or.Filters = or.allowQueryFilters(or.Filters, fields)
+	return or
+}
+
+// Where works like PageRequest.Where.
+func (or OffsetRequest) Where() string {
+	where, _ := filtersWhere(or.Filters)
+	return where
+}
+
+// WhereArgs works like PageRequest.WhereArgs.
+func (or OffsetRequest) WhereArgs() []any {
+	_, args := filtersWhere(or.Filters)
+	return args
+}
+
+// SelectableFields works like PageRequest.SelectableFields.
+func (or OffsetRequest) SelectableFields(fields ...string) OffsetRequest {
+	or.Fields = or.allowQueryFields(or.Fields, fields)
+	return or
+}
+
+// Columns works like PageRequest.Columns.
+func (or OffsetRequest) Columns(fieldMap map[string]string, always ...string) []string {
+	return selectColumns(or.Fields, fieldMap, always)
+}
+
+// OffsetLink returns query parameters for a link to the items starting at offset.
+// Sorts, filters, fields and limit are carried over, in the format they were
+// parsed with, so the link reproduces the same ordering and result set. Sorts
+// are written as they were before mapping, as for PageRequest.PageLink.
+func (or OffsetRequest) OffsetLink(offset int) url.Values {
+	values := url.Values{}
+	values.Set(paramOffset, strconv.Itoa(offset))
+	values.Set(paramLimit, strconv.Itoa(or.Limit))
+	or.setLinkParams(values, or.Sort, or.Filters, or.Fields)
+	return values
+}
+
+// ToPageRequest converts the request to a PageRequest with Size = Limit,
+// keeping the sorts, filters and fields. Reports false if Offset is not a
+// multiple of Limit, since the range does not start on a page boundary.
+func (or OffsetRequest) ToPageRequest() (PageRequest, bool) {
+	if or.Limit < 1 || or.Offset%or.Limit != 0 {
+		return PageRequest{}, false
+	}
+	return PageRequest{
+		Page:         or.Offset/or.Limit + or.query.firstPage(),
+		Size:         or.Limit,
+		Sort:         or.Sort,
+		Filters:      or.Filters,
+		Fields:       or.Fields,
+		requestState: or.requestState,
+	}, true
+}
+
+// ToOffsetRequest converts the request to an OffsetRequest with
+// Offset = Offset() and Limit = Size, keeping the sorts, filters and fields.
+// Every page maps to an offset range, so the conversion always succeeds.
+func (pr PageRequest) ToOffsetRequest() OffsetRequest {
+	return OffsetRequest{
+		Offset:       pr.Offset(),
+		Limit:        pr.Size,
+		Sort:         pr.Sort,
+		Filters:      pr.Filters,
+		Fields:       pr.Fields,
+		requestState: pr.requestState,
+	}
+}
@@ -0,0 +1,111 @@
+package pageable
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestOffsetRequestFromQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		values url.Values
+		offset int
+		limit  int
+	}{
+		{name: "defaults", values: url.Values{}, offset: 0, limit: DefaultSize},
+		{name: "unaligned", values: url.Values{"offset": {"37"}, "limit": {"10"}}, offset: 37, limit: 10},
+		{name: "negative offset", values: url.Values{"offset": {"-5"}}, offset: 0, limit: DefaultSize},
+		{name: "invalid limit", values: url.Values{"limit": {"abc"}}, offset: 0, limit: DefaultSize},
+		{name: "limit clamped", values: url.Values{"limit": {"5000"}}, offset: 0, limit: MaxSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := OffsetRequestFromQuery(tt.values)
+			if req.Offset != tt.offset || req.Limit != tt.limit {
+				t.Errorf("offset %d, limit %d; want %d, %d", req.Offset, req.Limit, tt.offset, tt.limit)
+			}
+			if len(req.Filters) != 0 {
+				t.Errorf("Filters = %v, want none", req.Filters)
+			}
+		})
+	}
+}
+
+func TestNewOffsetRequest(t *testing.T) {
+	req := NewOffsetRequest(-1, 0, nil)
+	if req.Offset != 0 || req.Limit != DefaultSize {
+		t.Errorf("NewOffsetRequest(-1, 0) = %+v", req)
+	}
+}
+
+func TestOffsetRequestSorting(t *testing.T) {
+	req := OffsetRequestFromQuery(url.Values{"sort": {"name,desc", "secret"}, "status": {"active"}}).
+		SortableFields("name").
+		WithTieBreaker(Sort{Field: "id", Direction: ASC}).
+		FilterableFields("status")
+
+	if got := req.OrderBy(); got != "name desc, id asc" {
+		t.Errorf("OrderBy() = %q", got)
+	}
+	if got := req.Where(); got != "status = ?" {
+		t.Errorf("Where() = %q", got)
+	}
+	if got := req.OffsetLink(20).Encode(); got != "limit=10&offset=20&sort=name%2Cdesc&status=active" {
+		t.Errorf("OffsetLink = %q", got)
+	}
+}
+
+func TestOffsetRequestOffsetLinkRoundTrip(t *testing.T) {
+	build := func(values url.Values) OffsetRequest {
+		return OffsetRequestFromQuery(values).
+			SortableFields("createdAt").
+			MapSortFields(map[string]string{"createdAt": "created_at"}).
+			WithTieBreaker(Sort{Field: "id", Direction: ASC})
+	}
+	req := build(url.Values{"sort": {"createdAt,desc"}})
+
+	link := req.OffsetLink(10)
+	if got, want := link.Encode(), "limit=10&offset=10&sort=createdAt%2Cdesc"; got != want {
+		t.Errorf("OffsetLink = %q, want %q", got, want)
+	}
+	if got, want := build(link).OrderBy(), req.OrderBy(); got != want {
+		t.Errorf("OrderBy() from link = %q, want %q", got, want)
+	}
+}
+
+func TestOffsetRequestConversions(t *testing.T) {
+	sorts := []Sort{{Field: "id", Direction: ASC}}
+
+	pr, ok := NewOffsetRequest(40, 20, sorts).ToPageRequest()
+	if !ok || pr.Page != 3 || pr.Size != 20 || len(pr.Sort) != 1 {
+		t.Errorf("ToPageRequest() = %+v, %v", pr, ok)
+	}
+	if _, ok := NewOffsetRequest(37, 10, sorts).ToPageRequest(); ok {
+		t.Error("ToPageRequest() ok for unaligned offset")
+	}
+
+	or := NewPageRequest(3, 20, sorts).ToOffsetRequest()
+	if or.Offset != 40 || or.Limit != 20 || len(or.Sort) != 1 {
+		t.Errorf("ToOffsetRequest() = %+v", or)
+	}
+}
+
+func TestNewOffsetPage(t *testing.T) {
+	req := NewOffsetRequest(37, 10, nil)
+
+	page := NewOffsetPage(make([]testItem, 10), req, 95)
+	want := OffsetPageMetadata{Offset: 37, Limit: 10, TotalItems: 95, HasMore: true}
+	if page.Metadata != want {
+		t.Errorf("Metadata = %+v, want %+v", page.Metadata, want)
+	}
+
+	last := NewOffsetPage(make([]testItem, 5), NewOffsetRequest(90, 10, nil), 95)
+	if last.Metadata.HasMore {
+		t.Error("HasMore = true on the last page")
+	}
+
+	if empty := EmptyOffsetPage[testItem](req); empty.Items == nil || empty.Metadata.HasMore {
+		t.Errorf("EmptyOffsetPage = %+v", empty)
+	}
+}
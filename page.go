@@ -6,12 +6,36 @@ type PageMetadata struct {
 	Size       int   `json:"size"`
 	TotalItems int64 `json:"totalItems"`
 	TotalPages int   `json:"totalPages"`
+
+	// PageDetails holds computed navigation fields, set by Page.WithDetails.
+	// It is nil by default, so they are omitted from JSON.
+	*PageDetails
+
+	// offset and ranged carry the request's range (see PageRequest.WithRange).
+	offset int64
+	ranged bool
+	// zeroBased is set for requests parsed with WithPageBase(0).
+	zeroBased bool
+}
+
+// PageDetails holds navigation fields computed from PageMetadata, for UIs
+// showing "Showing 21-40 of 95" or disabling first/last links.
+type PageDetails struct {
+	HasNext          bool  `json:"hasNext"`
+	HasPrev          bool  `json:"hasPrev"`
+	IsFirst          bool  `json:"isFirst"`
+	IsLast           bool  `json:"isLast"`
+	FromItem         int64 `json:"fromItem"`
+	ToItem           int64 `json:"toItem"`
+	NumberOfElements int   `json:"numberOfElements"`
 }
 
 // Page represents a paginated response for offset-based pagination.
 type Page[T any] struct {
 	Items    []T          `json:"items"`
 	Metadata PageMetadata `json:"metadata"`
+
+	render pageRender
 }
 
 // EmptyPage creates an empty Page with zero results, preserving the request's page and size.
@@ -39,6 +63,120 @@ func NewPage[T any](items []T, request PageRequest, totalItems int64) Page[T] {
 			Size:       request.Size,
 			TotalItems: totalItems,
 			TotalPages: totalPages,
+			offset:     int64(request.offset),
+			ranged:     request.ranged,
+			zeroBased:  request.query.zeroBased,
 		},
 	}
 }
+
+// SelectFields returns the page with items marshaled to JSON with only the
+// given top-level fields (e.g., the request's Fields), named as in the items'
+// JSON encoding. The envelope is unchanged. No fields marshals items whole.
+func (p Page[T]) SelectFields(fields ...string) Page[T] {
+	p.render.fields = fields
+	return p
+}
+
+// WithEnvelope returns the page marshaled to JSON with the key names and
+// shape of e instead of the default "items"/"metadata" envelope.
+func (p Page[T]) WithEnvelope(e Envelope) Page[T] {
+	p.render.envelope = &e
+	return p
+}
+
+// MarshalJSON implements json.Marshaler, applying the fields set by
+// SelectFields and the envelope set by WithEnvelope.
+func (p Page[T]) MarshalJSON() ([]byte, error) {
+	return marshalPage(p.Items, p.Metadata, p.render)
+}
+
+// Details computes the navigation fields for a page holding numberOfElements items.
+// FromItem and ToItem are 1-based positions of the first and last item, or zero
+// for an empty page. A page past the last one is neither first nor last.
+func (m PageMetadata) Details(numberOfElements int) PageDetails {
+	first := m.firstPage()
+	d := PageDetails{
+		HasNext:          m.Page < m.lastPage(),
+		HasPrev:          m.Page > first,
+		IsFirst:          m.Page == first,
+		IsLast:           m.Page == m.lastPage() || (m.TotalPages == 0 && m.Page == first),
+		NumberOfElements: numberOfElements,
+	}
+	if numberOfElements > 0 {
+		offset := m.firstItem()
+		d.FromItem = offset + 1
+		d.ToItem = offset + int64(numberOfElements)
+	}
+	return d
+}
+
+// firstItem returns the zero-based position of the page's first item.
+func (m PageMetadata) firstItem() int64 {
+	if m.ranged {
+		return m.offset
+	}
+	return int64(m.Page-m.firstPage()) * int64(m.Size)
+}
+
+// firstPage returns the number of the first page: 0 for zero-indexed pages, else 1.
+func (m PageMetadata) firstPage() int {
+	if m.zeroBased {
+		return 0
+	}
+	return DefaultPage
+}
+
+// lastPage returns the number of the last page, or firstPage - 1 if there are no pages.
+func (m PageMetadata) lastPage() int {
+	return m.firstPage() + m.TotalPages - 1
+}
+
+// WithDetails returns the page with the computed navigation fields included
+// in its metadata (see PageDetails).
+func (p Page[T]) WithDetails() Page[T] {
+	d := p.Metadata.Details(len(p.Items))
+	p.Metadata.PageDetails = &d
+	return p
+}
+
+// PageGap marks elided pages in the list returned by PageWindow.
+// It is negative so it never collides with page 0 of zero-indexed pages.
+const PageGap = -1
+
+// PageWindow returns the page numbers to show in pagination controls: the
+// first and last pages, and siblings pages on each side of the current page,
+// with PageGap where pages are elided. For page 5 of 20 with one sibling, it
+// returns [1 -1 4 5 6 -1 20]. A gap of a single page is shown as that page.
+// Page numbers follow the request's page base. Returns nil if there are no pages.
+func (m PageMetadata) PageWindow(siblings int) []int {
+	if m.TotalPages < 1 {
+		return nil
+	}
+	siblings = max(siblings, 0)
+	first, last := m.firstPage(), m.lastPage()
+	current := min(max(m.Page, first), last)
+	start, end := max(first, current-siblings), min(last, current+siblings)
+
+	var pages []int
+	if start > first {
+		pages = append(pages, first)
+		if start == first+2 {
+			pages = append(pages, first+1)
+		} else if start > first+2 {
+			pages = append(pages, PageGap)
+		}
+	}
+	for n := start; n <= end; n++ {
+		pages = append(pages, n)
+	}
+	if end < last {
+		if end == last-2 {
+			pages = append(pages, last-1)
+		} else if end < last-2 {
+			pages = append(pages, PageGap)
+		}
+		pages = append(pages, last)
+	}
+	return pages
+}
@@ -1,23 +1,32 @@
 package pageable
 
 import (
+	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 // PageRequest represents offset-based pagination parameters.
 type PageRequest struct {
-	Page int
-	Size int
-	Sort []Sort
+	Page    int
+	Size    int
+	Sort    []Sort
+	Filters []Filter
+	Fields  []string
+
+	requestState
+	// offset is the exact start of an HTTP range, which may fall between pages (see WithRange).
+	offset int
+	ranged bool
 }
 
 // NewPageRequest creates a PageRequest with defaults applied.
-// Page is clamped to a minimum of 1. Size is clamped to [1, MaxSize].
-func NewPageRequest(page, size int, sort []Sort) PageRequest {
-	if page < 1 {
-		page = DefaultPage
+// Page is clamped to a minimum of 1, or 0 with WithPageBase(0). Size is
+// clamped to [1, MaxSize]. Options set the page base and the format of links.
+func NewPageRequest(page, size int, sort []Sort, opts ...QueryOption) PageRequest {
+	query := newQueryConfig(opts)
+	if page < query.firstPage() {
+		page = query.firstPage()
 	}
 	if size < 1 {
 		size = DefaultSize
@@ -25,23 +34,26 @@ func NewPageRequest(page, size int, sort []Sort) PageRequest {
 	if size > MaxSize {
 		size = MaxSize
 	}
-	return PageRequest{Page: page, Size: size, Sort: sort}
+	return PageRequest{Page: page, Size: size, Sort: sort, requestState: requestState{query: query}}
 }
 
 // PageRequestFromQuery parses a PageRequest from URL query parameters.
-// Recognized keys: "page", "size", "sort".
-// Uses DefaultPage and DefaultSize for missing or invalid values.
-// Size is clamped to [1, MaxSize].
-func PageRequestFromQuery(values url.Values) PageRequest {
-	page := DefaultPage
-	if v := values.Get("page"); v != "" {
-		if p, err := strconv.Atoi(v); err == nil && p > 0 {
+// Recognized keys: "page", "size", "sort", "fields"; all other keys are parsed as
+// filters (see ParseFilters), applied once allowed by FilterableFields. Uses DefaultPage and DefaultSize for missing or
+// invalid values. Size is clamped to [1, MaxSize]. Options change how sorts
+// and page numbers are read.
+func PageRequestFromQuery(values url.Values, opts ...QueryOption) PageRequest {
+	query := newQueryConfig(opts)
+
+	page := query.firstPage()
+	if v := values.Get(paramPage); v != "" {
+		if p, err := strconv.Atoi(v); err == nil && p >= query.firstPage() {
 			page = p
 		}
 	}
 
 	size := DefaultSize
-	if v := values.Get("size"); v != "" {
+	if v := values.Get(paramSize); v != "" {
 		if s, err := strconv.Atoi(v); err == nil && s > 0 {
 			size = s
 		}
@@ -50,18 +62,22 @@ func PageRequestFromQuery(values url.Values) PageRequest {
 		size = MaxSize
 	}
 
-	var sort []Sort
-	if sortParams := values["sort"]; len(sortParams) > 0 {
-		sort = ParseSorts(sortParams)
+	return PageRequest{
+		Page:         page,
+		Size:         size,
+		Sort:         query.parseSorts(values),
+		requestState: newRequestState(values, query),
 	}
-
-	return PageRequest{Page: page, Size: size, Sort: sort}
 }
 
 // Offset returns the zero-based offset for database queries.
-// Calculated as (Page - 1) * Size.
+// Calculated as (Page - 1) * Size, or Page * Size with WithPageBase(0),
+// or the range start for requests from WithRange.
 func (pr PageRequest) Offset() int {
-	return (pr.Page - 1) * pr.Size
+	if pr.ranged {
+		return pr.offset
+	}
+	return (pr.Page - pr.query.firstPage()) * pr.Size
 }
 
 // Limit returns the page size (alias for clarity in SQL-style usage).
@@ -76,11 +92,31 @@ func (pr PageRequest) SortableFields(fields ...string) PageRequest {
 	return pr
 }
 
+// ApplySortPolicy filters the sorts through policy (see SortPolicy.Apply).
+// In strict mode, a *SortPolicyError is returned for any violation.
+func (pr PageRequest) ApplySortPolicy(policy SortPolicy) (PageRequest, error) {
+	sorts, err := policy.Apply(pr.Sort)
+	if err != nil {
+		return pr, err
+	}
+	pr.Sort = sorts
+	return pr, nil
+}
+
 // MapSortFields replaces sort field names using the provided mapping.
 // Use this to translate user-facing field names (e.g., "createdAt") to
 // database column names (e.g., "created_at"). Unmapped fields are kept as-is.
 func (pr PageRequest) MapSortFields(fieldMap map[string]string) PageRequest {
-	pr.Sort = mapSortFields(pr.Sort, fieldMap)
+	pr.Sort = pr.mapSortFields(pr.Sort, fieldMap)
+	return pr
+}
+
+// MapJSONSortFields makes sorts on a declared alias (e.g., "attributes.color")
+// order by a value inside a JSON column, rendered per dialect. The alias stays
+// the sort field and cursor key. Use SortableFields to allow the aliases.
+// Declare fields once with NewJSONSortFields, which validates the paths.
+func (pr PageRequest) MapJSONSortFields(fields JSONSortFields) PageRequest {
+	pr.Sort = pr.mapJSONSortFields(pr.Sort, fields)
 	return pr
 }
 
@@ -93,16 +129,137 @@ func (pr PageRequest) WithDefaultSort(sorts ...Sort) PageRequest {
 	return pr
 }
 
+// WithTieBreaker appends the unique key tie to the sorts unless a sort on the same field exists.
+func (pr PageRequest) WithTieBreaker(tie Sort) PageRequest {
+	pr.Sort = pr.appendTieBreaker(pr.Sort, tie)
+	return pr
+}
+
+// WithDialect sets the SQL dialect used by OrderBy.
+// The default is ANSI.
+func (pr PageRequest) WithDialect(d Dialect) PageRequest {
+	pr.dialect = d
+	return pr
+}
+
 // OrderBy returns an ORDER BY clause string from the request's sorts.
-// Returns a string like "name desc, id asc".
-// Returns an empty string if no sorts are set.
+// Returns a string like "name desc, id asc", rendered for the request's dialect.
+// Returns an empty string if no sorts are set. Bind arguments of sort
+// expressions are returned by OrderByArgs.
 func (pr PageRequest) OrderBy() string {
-	if len(pr.Sort) == 0 {
-		return ""
+	ob, _ := pr.orderBy(pr.Sort)
+	return ob
+}
+
+// OrderByArgs returns the bind arguments for the "?" placeholders in OrderBy,
+// which only sort expressions with Args produce.
+func (pr PageRequest) OrderByArgs() []any {
+	_, args := pr.orderBy(pr.Sort)
+	return args
+}
+
+// MapSortExpressions makes sorts on a registered alias order by its trusted SQL
+// expression. The alias stays the sort field and cursor key, so rows should
+// select the expression under the alias (e.g., "SELECT (likes*2) AS rank").
+// Use SortableFields to allow the aliases.
+func (pr PageRequest) MapSortExpressions(exprs SortExpressions) PageRequest {
+	pr.Sort = pr.mapSortExpressions(pr.Sort, exprs)
+	return pr
+}
+
+// FilterableFields sets Filters to the filters on the specified fields, taken
+// from Filters and the query. Filters parsed from the query are only used after
+// FilterableFields allows their fields; all others are dropped.
+func (pr PageRequest) FilterableFields(fields ...string) PageRequest {
+	pr.Filters = pr.allowQueryFilters(pr.Filters, fields)
+	return pr
+}
+
+// Where returns the request's filters as a condition for the WHERE clause,
+// joined with AND and using "?" placeholders (e.g., "status = ? AND id IN (?, ?)").
+// Use it for both the page query and the count query.
+// Returns an empty string if no filters are set. Bind arguments are returned by WhereArgs.
+func (pr PageRequest) Where() string {
+	where, _ := filtersWhere(pr.Filters)
+	return where
+}
+
+// WhereArgs returns the bind arguments for the "?" placeholders in Where.
+func (pr PageRequest) WhereArgs() []any {
+	_, args := filtersWhere(pr.Filters)
+	return args
+}
+
+// SelectableFields sets Fields to the selected fields in the specified list,
+// taken from Fields and the query. Fields parsed from the query are only used
+// after SelectableFields allows them, so until then Columns returns nil and
+// all fields are returned; the same holds if none remain.
+func (pr PageRequest) SelectableFields(fields ...string) PageRequest {
+	pr.Fields = pr.allowQueryFields(pr.Fields, fields)
+	return pr
+}
+
+// Columns returns the selected fields as a column list for SELECT, with names
+// translated by fieldMap (unmapped fields are kept as-is) and the always columns
+// appended when missing, e.g. the sort and cursor keys. Returns nil if no
+// fields are selected, meaning all columns.
+func (pr PageRequest) Columns(fieldMap map[string]string, always ...string) []string {
+	return selectColumns(pr.Fields, fieldMap, always)
+}
+
+// PageLink returns query parameters for a link to page. Sorts, filters,
+// fields and size are carried over, in the format they were parsed with,
+// so the link reproduces the same ordering and result set. Sorts are written
+// as they were before MapSortFields, MapJSONSortFields, MapSortExpressions
+// and WithTieBreaker, so the link parses back through the same builders.
+func (pr PageRequest) PageLink(page int) url.Values {
+	values := url.Values{}
+	values.Set(paramPage, strconv.Itoa(page))
+	values.Set(paramSize, strconv.Itoa(pr.Size))
+	pr.setLinkParams(values, pr.Sort, pr.Filters, pr.Fields)
+	return values
+}
+
+// OffsetTooLargeError is returned by CheckMaxOffset when a request's offset
+// exceeds the configured maximum.
+type OffsetTooLargeError struct {
+	Page      int
+	Size      int
+	MaxOffset int
+}
+
+// Error implements the error interface.
+func (e *OffsetTooLargeError) Error() string {
+	return fmt.Sprintf("pageable: page %d with size %d exceeds max offset %d", e.Page, e.Size, e.MaxOffset)
+}
+
+// MaxPage returns the last page that can be requested with the current size
+// without the offset exceeding maxOffset.
+func (pr PageRequest) MaxPage(maxOffset int) int {
+	if pr.Size < 1 || maxOffset < 0 {
+		return pr.query.firstPage()
 	}
-	parts := make([]string, len(pr.Sort))
-	for i, s := range pr.Sort {
-		parts[i] = s.Field + " " + string(s.Direction)
+	return maxOffset/pr.Size + pr.query.firstPage()
+}
+
+// CheckMaxOffset returns an *OffsetTooLargeError if the request's offset exceeds maxOffset.
+// The check is done on the page number, so it cannot overflow for huge pages.
+// Use DefaultMaxOffset as a sensible limit, and ToCursorRequest to continue past it.
+func (pr PageRequest) CheckMaxOffset(maxOffset int) error {
+	if pr.Page > pr.MaxPage(maxOffset) {
+		return &OffsetTooLargeError{Page: pr.Page, Size: pr.Size, MaxOffset: maxOffset}
 	}
-	return strings.Join(parts, ", ")
+	return nil
+}
+
+// ToCursorRequest converts the request to a CursorRequest starting at cursor,
+// keeping the size, sorts, filters and fields. This is the migration path for deep pages:
+// encode a cursor from the last item of the deepest allowed page and let the
+// client continue with keyset pagination from there.
+func (pr PageRequest) ToCursorRequest(cursor string) CursorRequest {
+	cr := NewCursorRequest(cursor, pr.Size, pr.Sort)
+	cr.Filters = pr.Filters
+	cr.Fields = pr.Fields
+	cr.requestState = pr.requestState
+	return cr
 }
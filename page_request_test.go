@@ -1,7 +1,9 @@
 package pageable
 
 import (
+	"errors"
 	"net/url"
+	"reflect"
 	"testing"
 )
 
@@ -322,3 +324,194 @@ func TestPageRequestOrderBy(t *testing.T) {
 		})
 	}
 }
+
+func TestPageRequestCheckMaxOffset(t *testing.T) {
+	tests := []struct {
+		name      string
+		page      int
+		size      int
+		maxOffset int
+		wantErr   bool
+	}{
+		{"first page", 1, 10, 100, false},
+		{"offset equals max", 11, 10, 100, false},
+		{"offset exceeds max", 12, 10, 100, true},
+		{"huge page", 999999, 1000, DefaultMaxOffset, true},
+		{"max int page", int(^uint(0) >> 1), 1000, DefaultMaxOffset, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := PageRequest{Page: tt.page, Size: tt.size}
+			err := req.CheckMaxOffset(tt.maxOffset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckMaxOffset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			var offsetErr *OffsetTooLargeError
+			if !errors.As(err, &offsetErr) {
+				t.Fatalf("error type = %T, want *OffsetTooLargeError", err)
+			}
+			if offsetErr.Page != tt.page || offsetErr.MaxOffset != tt.maxOffset {
+				t.Errorf("error = %+v", offsetErr)
+			}
+		})
+	}
+}
+
+func TestPageRequestMaxPage(t *testing.T) {
+	req := PageRequest{Page: 1, Size: 20}
+	if got := req.MaxPage(100); got != 6 {
+		t.Errorf("MaxPage(100) = %d, want 6", got)
+	}
+}
+
+func TestPageRequestToCursorRequest(t *testing.T) {
+	req := PageRequest{Page: 50, Size: 20, Sort: []Sort{{Field: "id", Direction: ASC}}}
+	cr := req.ToCursorRequest("abc")
+	if cr.Cursor != "abc" {
+		t.Errorf("Cursor = %q, want %q", cr.Cursor, "abc")
+	}
+	if cr.Size != 20 {
+		t.Errorf("Size = %d, want 20", cr.Size)
+	}
+	if len(cr.Sort) != 1 || cr.Sort[0] != req.Sort[0] {
+		t.Errorf("Sort = %v, want %v", cr.Sort, req.Sort)
+	}
+}
+
+func TestPageRequestWithTieBreaker(t *testing.T) {
+	req := PageRequest{Page: 1, Size: 10, Sort: []Sort{{Field: "name", Direction: ASC}}}.
+		WithTieBreaker(Sort{Field: "id", Direction: ASC})
+	if got := req.OrderBy(); got != "name asc, id asc" {
+		t.Errorf("OrderBy() = %q, want %q", got, "name asc, id asc")
+	}
+}
+
+func TestPageRequestPageLinkRoundTrip(t *testing.T) {
+	build := func(values url.Values) PageRequest {
+		return PageRequestFromQuery(values).
+			SortableFields("createdAt").
+			MapSortFields(map[string]string{"createdAt": "created_at"}).
+			WithTieBreaker(Sort{Field: "id", Direction: ASC})
+	}
+	req := build(url.Values{"sort": {"createdAt,desc"}})
+
+	link := req.PageLink(2)
+	if got, want := link.Encode(), "page=2&size=10&sort=createdAt%2Cdesc"; got != want {
+		t.Errorf("PageLink = %q, want %q", got, want)
+	}
+	if got, want := build(link).OrderBy(), req.OrderBy(); got != want {
+		t.Errorf("OrderBy() from link = %q, want %q", got, want)
+	}
+}
+
+func TestZeroBasedPageRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		values url.Values
+		page   int
+		offset int
+	}{
+		{name: "default is page 0", values: url.Values{"size": {"20"}}, page: 0, offset: 0},
+		{name: "page 0 kept", values: url.Values{"page": {"0"}, "size": {"20"}}, page: 0, offset: 0},
+		{name: "page 2", values: url.Values{"page": {"2"}, "size": {"20"}}, page: 2, offset: 40},
+		{name: "negative page reset", values: url.Values{"page": {"-1"}, "size": {"20"}}, page: 0, offset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := PageRequestFromQuery(tt.values, WithPageBase(0))
+			if req.Page != tt.page || req.Offset() != tt.offset {
+				t.Errorf("page %d, offset %d; want %d, %d", req.Page, req.Offset(), tt.page, tt.offset)
+			}
+		})
+	}
+
+	if req := PageRequestFromQuery(url.Values{"page": {"0"}}); req.Page != 1 {
+		t.Errorf("one-based page 0 = %d, want 1", req.Page)
+	}
+	if req := NewPageRequest(0, 20, nil, WithPageBase(0)); req.Page != 0 || req.Offset() != 0 {
+		t.Errorf("NewPageRequest page %d, offset %d; want 0, 0", req.Page, req.Offset())
+	}
+	if req := NewPageRequest(0, 20, nil); req.Page != 1 {
+		t.Errorf("one-based NewPageRequest page 0 = %d, want 1", req.Page)
+	}
+}
+
+func TestZeroBasedPageMetadata(t *testing.T) {
+	req := PageRequestFromQuery(url.Values{"page": {"4"}, "size": {"20"}}, WithPageBase(0))
+	page := NewPage(make([]testItem, 15), req, 95).WithDetails()
+
+	want := PageDetails{HasPrev: true, IsLast: true, FromItem: 81, ToItem: 95, NumberOfElements: 15}
+	if got := *page.Metadata.PageDetails; got != want {
+		t.Errorf("PageDetails = %+v, want %+v", got, want)
+	}
+	if got, want := page.Metadata.PageWindow(1), []int{0, PageGap, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PageWindow = %v, want %v", got, want)
+	}
+	if got := page.Metadata.contentRange(); got != "items 80-94/95" {
+		t.Errorf("Content-Range = %q", got)
+	}
+
+	req.Page = 0
+	first := NewPage(make([]testItem, 20), req, 95).WithDetails()
+	if d := first.Metadata.PageDetails; !d.IsFirst || d.HasPrev || !d.HasNext {
+		t.Errorf("first page details = %+v", d)
+	}
+}
+
+func TestZeroBasedHALLinks(t *testing.T) {
+	u, _ := url.Parse("/users?page=0&size=10")
+	req := PageRequestFromQuery(u.Query(), WithPageBase(0))
+	links := newPageLinks(u, req, NewPage(make([]testItem, 10), req, 25).Metadata)
+
+	if links.first != "/users?page=0&size=10" || links.last != "/users?page=2&size=10" ||
+		links.next != "/users?page=1&size=10" || links.prev != "" {
+		t.Errorf("links = %+v", links)
+	}
+}
+
+func TestZeroBasedHybridRequest(t *testing.T) {
+	req := HybridRequestFromQuery(url.Values{"page": {"0"}, "size": {"10"}}, WithPageBase(0))
+	if req.Page != 1 {
+		t.Errorf("Page = %d, want 1 internally", req.Page)
+	}
+	page := NewHybridPage([]int{1}, req, "", "", true, 30)
+	if page.Metadata.Page != 0 || page.Metadata.HasPrev {
+		t.Errorf("Metadata = %+v, want page 0 without prev", page.Metadata)
+	}
+}
+
+func TestZeroBasedNewHybridRequest(t *testing.T) {
+	req := NewHybridRequest(0, "", 10, nil, WithPageBase(0))
+	if req.Page != 1 {
+		t.Errorf("Page = %d, want 1 internally", req.Page)
+	}
+	if got := req.PageLink(1, "").Get("page"); got != "1" {
+		t.Errorf("PageLink page = %q, want %q", got, "1")
+	}
+	if page := NewHybridPage([]int{1}, req, "", "", true, 30); page.Metadata.Page != 0 {
+		t.Errorf("Metadata.Page = %d, want 0", page.Metadata.Page)
+	}
+}
+
+func TestZeroBasedConversions(t *testing.T) {
+	req := PageRequestFromQuery(url.Values{"page": {"3"}, "size": {"10"}}, WithPageBase(0))
+	if or := req.ToOffsetRequest(); or.Offset != 30 {
+		t.Errorf("ToOffsetRequest().Offset = %d, want 30", or.Offset)
+	}
+
+	ranged, err := req.WithRange("items=0-9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ranged.Page != 0 {
+		t.Errorf("WithRange page = %d, want 0", ranged.Page)
+	}
+	if got := req.MaxPage(100); got != 10 {
+		t.Errorf("MaxPage(100) = %d, want 10", got)
+	}
+}
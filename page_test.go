@@ -2,6 +2,7 @@ package pageable
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -121,3 +122,109 @@ func TestPageJSONSerialization(t *testing.T) {
 		}
 	}
 }
+
+func TestPageJSONWithoutDetails(t *testing.T) {
+	b, err := json.Marshal(NewPage([]testItem{{ID: 1}}, PageRequest{Page: 1, Size: 2}, 5).Metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"page":1,"size":2,"totalItems":5,"totalPages":3}`
+	if string(b) != want {
+		t.Errorf("metadata JSON = %s, want %s", b, want)
+	}
+}
+
+func TestPageWithDetails(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     int
+		items    int
+		total    int64
+		expected PageDetails
+	}{
+		{
+			name:     "first page",
+			page:     1,
+			items:    20,
+			total:    95,
+			expected: PageDetails{HasNext: true, IsFirst: true, FromItem: 1, ToItem: 20, NumberOfElements: 20},
+		},
+		{
+			name:     "middle page",
+			page:     2,
+			items:    20,
+			total:    95,
+			expected: PageDetails{HasNext: true, HasPrev: true, FromItem: 21, ToItem: 40, NumberOfElements: 20},
+		},
+		{
+			name:     "last partial page",
+			page:     5,
+			items:    15,
+			total:    95,
+			expected: PageDetails{HasPrev: true, IsLast: true, FromItem: 81, ToItem: 95, NumberOfElements: 15},
+		},
+		{
+			name:     "empty result",
+			page:     1,
+			items:    0,
+			total:    0,
+			expected: PageDetails{IsFirst: true, IsLast: true},
+		},
+		{
+			name:     "past the last page",
+			page:     9,
+			items:    0,
+			total:    95,
+			expected: PageDetails{HasPrev: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := NewPage(make([]testItem, tt.items), PageRequest{Page: tt.page, Size: 20}, tt.total).WithDetails()
+			if page.Metadata.PageDetails == nil {
+				t.Fatal("PageDetails = nil")
+			}
+			if got := *page.Metadata.PageDetails; got != tt.expected {
+				t.Errorf("PageDetails = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPageWithDetailsJSON(t *testing.T) {
+	page := NewPage([]testItem{{ID: 21}}, PageRequest{Page: 2, Size: 1}, 3).WithDetails()
+	b, err := json.Marshal(page.Metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"page":2,"size":1,"totalItems":3,"totalPages":3,"hasNext":true,"hasPrev":true,` +
+		`"isFirst":false,"isLast":false,"fromItem":2,"toItem":2,"numberOfElements":1}`
+	if string(b) != want {
+		t.Errorf("metadata JSON = %s, want %s", b, want)
+	}
+}
+
+func TestPageWindow(t *testing.T) {
+	tests := []struct {
+		page, total, siblings int
+		expected              []int
+	}{
+		{5, 20, 1, []int{1, PageGap, 4, 5, 6, PageGap, 20}},
+		{1, 20, 1, []int{1, 2, PageGap, 20}},
+		{20, 20, 1, []int{1, PageGap, 19, 20}},
+		{3, 20, 1, []int{1, 2, 3, 4, PageGap, 20}},
+		{4, 7, 1, []int{1, 2, 3, 4, 5, 6, 7}},
+		{2, 3, 2, []int{1, 2, 3}},
+		{1, 1, 1, []int{1}},
+		{50, 20, 0, []int{1, PageGap, 20}},
+		{1, 0, 1, nil},
+	}
+
+	for _, tt := range tests {
+		m := PageMetadata{Page: tt.page, TotalPages: tt.total}
+		if got := m.PageWindow(tt.siblings); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("PageWindow(page %d of %d, %d) = %v, want %v", tt.page, tt.total, tt.siblings, got, tt.expected)
+		}
+	}
+}
@@ -3,14 +3,35 @@ package pageable
 
 const (
 	// DefaultPage is the default page number (1-indexed).
+	// Requests parsed with WithPageBase(0) start at page 0 instead.
 	DefaultPage = 1
 	// DefaultSize is the default number of items per page.
 	DefaultSize = 10
 	// MaxSize is the maximum allowed page size.
 	MaxSize = 1000
+	// DefaultMaxOffset is a suggested offset limit for PageRequest.CheckMaxOffset.
+	// Beyond this, OFFSET scans get expensive and keyset pagination should be used.
+	DefaultMaxOffset = 10000
+	// MaxPageJump is the default number of pages a HybridRequest may skip past
+	// its cursor (see HybridRequest.WithMaxPageJump).
+	MaxPageJump = 10
 
 	// DefaultCursorSize is the default number of items for cursor-based pagination.
 	DefaultCursorSize = 10
 	// MaxCursorSize is the maximum allowed cursor page size.
 	MaxCursorSize = 1000
 )
+
+// Query parameter keys recognized by the *FromQuery parsers.
+const (
+	paramPage   = "page"
+	paramSize   = "size"
+	paramSort   = "sort"
+	paramCursor = "cursor"
+	paramSearch = "q"
+	paramFields = "fields"
+	paramOffset = "offset"
+	paramLimit  = "limit"
+	paramSince  = "since"
+	paramUntil  = "until"
+)
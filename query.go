@@ -0,0 +1,181 @@
+package pageable
+
+import (
+	"net/url"
+)
+
+// QueryOption customizes how the *FromQuery parsers read query parameters,
+// and the page base of NewPageRequest and NewHybridRequest.
+// The request remembers the options, so links built from it use the same format.
+type QueryOption func(*queryConfig)
+
+// queryConfig holds the settings applied by QueryOptions. The zero value is the default format.
+type queryConfig struct {
+	sortParam  string
+	sortSyntax SortSyntax
+	zeroBased  bool
+}
+
+// WithSortSyntax parses sorts in the given syntax instead of "field,direction".
+func WithSortSyntax(syntax SortSyntax) QueryOption {
+	return func(c *queryConfig) {
+		c.sortSyntax = syntax
+	}
+}
+
+// WithSortParam reads sorts from the named query parameter instead of "sort"
+// (e.g., "order_by").
+func WithSortParam(name string) QueryOption {
+	return func(c *queryConfig) {
+		c.sortParam = name
+	}
+}
+
+// WithPageBase sets the number of the first page: 0 for zero-indexed pages,
+// as sent by Spring Data-compatible clients, or 1 (the default). Other values
+// are treated as 1. The base applies to parsing, Offset, page metadata and links.
+func WithPageBase(base int) QueryOption {
+	return func(c *queryConfig) {
+		c.zeroBased = base == 0
+	}
+}
+
+// newQueryConfig applies opts to the default configuration.
+func newQueryConfig(opts []QueryOption) queryConfig {
+	var c queryConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// firstPage returns the number of the first page.
+func (c queryConfig) firstPage() int {
+	if c.zeroBased {
+		return 0
+	}
+	return DefaultPage
+}
+
+// sortKey returns the query parameter holding sorts.
+func (c queryConfig) sortKey() string {
+	if c.sortParam == "" {
+		return paramSort
+	}
+	return c.sortParam
+}
+
+// parseSorts reads sorts from values in the configured parameter and syntax.
+func (c queryConfig) parseSorts(values url.Values) []Sort {
+	if raw := values[c.sortKey()]; len(raw) > 0 {
+		return c.sortSyntax.Parse(raw)
+	}
+	return nil
+}
+
+// setSorts writes sorts into values in the configured parameter and syntax.
+func (c queryConfig) setSorts(values url.Values, sorts []Sort) {
+	key := c.sortKey()
+	values.Del(key)
+	for _, v := range c.sortSyntax.Format(sorts) {
+		values.Add(key, v)
+	}
+}
+
+// requestState is the unexported state shared by the request types: the SQL
+// dialect, the format the request was parsed with, and query parameters
+// pending an allowlist. Each request embeds it, so the builder logic lives in
+// its methods and the request methods only wrap them in their own type.
+type requestState struct {
+	dialect Dialect
+	query   queryConfig
+	// queryFilters are filters parsed from the query, pending FilterableFields.
+	queryFilters []Filter
+	// queryFields are fields parsed from the query, pending SelectableFields.
+	queryFields []string
+	// linkSorts are the sorts before the first mapping or tie-breaker, with
+	// their public names, recorded when linkSortsSet is true (see PageRequest.PageLink).
+	linkSorts    []Sort
+	linkSortsSet bool
+}
+
+// newRequestState returns the state of a request parsed from values with
+// query, holding the query's filters and fields pending their allowlists.
+func newRequestState(values url.Values, query queryConfig) requestState {
+	return requestState{
+		query:        query,
+		queryFilters: query.parseFilters(values),
+		queryFields:  ParseFields(values[paramFields]),
+	}
+}
+
+// allowQueryFilters returns the filters on fields, taken from filters and the
+// pending query filters, and clears the pending filters (see PageRequest.FilterableFields).
+func (s *requestState) allowQueryFilters(filters []Filter, fields []string) []Filter {
+	filters = allowFilters(filters, s.queryFilters, fields...)
+	s.queryFilters = nil
+	return filters
+}
+
+// allowQueryFields returns the fields, taken from fields and the pending query
+// fields, that are in allowed, and clears the pending fields (see PageRequest.SelectableFields).
+func (s *requestState) allowQueryFields(fields, allowed []string) []string {
+	fields = filterFields(append(fields[:len(fields):len(fields)], s.queryFields...), allowed...)
+	s.queryFields = nil
+	return fields
+}
+
+// orderBy renders sorts as an ORDER BY clause for the request's dialect.
+func (s *requestState) orderBy(sorts []Sort) (string, []any) {
+	return orderBy(sorts, s.dialect)
+}
+
+// keyset returns the keyset for sorts after the cursor data, for the request's dialect.
+func (s *requestState) keyset(sorts []Sort, data CursorData) (Keyset, error) {
+	return newKeyset(sorts, data, s.dialect)
+}
+
+// recordLinkSorts records sorts as the link sorts unless already recorded.
+// It is called before sorts are first mapped or given a tie-breaker, so links
+// keep the names the client sent and parse back through the same builders.
+func (s *requestState) recordLinkSorts(sorts []Sort) {
+	if !s.linkSortsSet {
+		s.linkSorts, s.linkSortsSet = sorts, true
+	}
+}
+
+// mapSortFields records the link sorts and returns sorts renamed by fieldMap.
+func (s *requestState) mapSortFields(sorts []Sort, fieldMap map[string]string) []Sort {
+	s.recordLinkSorts(sorts)
+	return mapSortFields(sorts, fieldMap)
+}
+
+// mapJSONSortFields records the link sorts and returns sorts mapped to JSON paths.
+func (s *requestState) mapJSONSortFields(sorts []Sort, fields JSONSortFields) []Sort {
+	s.recordLinkSorts(sorts)
+	return mapJSONSortFields(sorts, fields)
+}
+
+// mapSortExpressions records the link sorts and returns sorts mapped to expressions.
+func (s *requestState) mapSortExpressions(sorts []Sort, exprs SortExpressions) []Sort {
+	s.recordLinkSorts(sorts)
+	return mapSortExpressions(sorts, exprs)
+}
+
+// appendTieBreaker records the link sorts and returns sorts with tie appended.
+func (s *requestState) appendTieBreaker(sorts []Sort, tie Sort) []Sort {
+	s.recordLinkSorts(sorts)
+	return appendTieBreaker(sorts, tie)
+}
+
+// setLinkParams writes sorts, filters and fields into link values, in the
+// format the request was parsed with. The recorded link sorts replace sorts
+// once the request's sorts were mapped.
+func (s *requestState) setLinkParams(values url.Values, sorts []Sort, filters []Filter, fields []string) {
+	if s.linkSortsSet {
+		sorts = s.linkSorts
+	}
+	s.query.setSorts(values, sorts)
+	setFilters(values, filters)
+	setFields(values, fields)
+}
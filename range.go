@@ -0,0 +1,84 @@
+package pageable
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// RangeUnit is the range unit of item ranges ("Range: items=0-24").
+const RangeUnit = "items"
+
+// ErrInvalidRange is returned by PageRequest.WithRange for a Range header
+// that is malformed, uses another unit, or has several or suffix ranges.
+var ErrInvalidRange = errors.New("pageable: invalid items range")
+
+// WithRange applies a "Range: items=first-last" header to the request, with
+// zero-based inclusive positions. Size becomes the range length, clamped to
+// [1, MaxSize]; an open range ("items=100-") keeps the current size. A range
+// starting on a page boundary maps to that page; an unaligned range keeps its
+// exact start, which Offset returns. An empty header leaves the request unchanged.
+// Sorts, filters and fields are kept. Returns ErrInvalidRange if the header
+// cannot be parsed.
+func (pr PageRequest) WithRange(header string) (PageRequest, error) {
+	if header == "" {
+		return pr, nil
+	}
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), RangeUnit+"=")
+	if !ok || strings.Contains(spec, ",") {
+		return pr, ErrInvalidRange
+	}
+	firstStr, lastStr, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return pr, ErrInvalidRange
+	}
+	first, err := strconv.Atoi(firstStr)
+	if err != nil || first < 0 {
+		return pr, ErrInvalidRange
+	}
+
+	size := pr.Size
+	if lastStr != "" {
+		last, err := strconv.Atoi(lastStr)
+		if err != nil || last < first {
+			return pr, ErrInvalidRange
+		}
+		size = min(last-first+1, MaxSize)
+	}
+	if size < 1 {
+		size = DefaultSize
+	}
+
+	pr.Size = size
+	pr.Page = first/size + pr.query.firstPage()
+	pr.offset = first
+	pr.ranged = true
+	return pr, nil
+}
+
+// WriteRange sets Accept-Ranges and, for requests built with
+// PageRequest.WithRange, Content-Range, then writes the status code and
+// returns it: 206 Partial Content for a satisfiable range, 416 Range Not
+// Satisfiable when the range starts past the last item, and 200 OK otherwise.
+// Set other headers before calling it, and write the items only when the
+// status is not 416.
+func (p Page[T]) WriteRange(w http.ResponseWriter) int {
+	h := w.Header()
+	h.Set("Accept-Ranges", RangeUnit)
+
+	status := http.StatusOK
+	if p.Metadata.ranged {
+		first, total := p.Metadata.firstItem(), p.Metadata.TotalItems
+		if first >= total || len(p.Items) == 0 {
+			status = http.StatusRequestedRangeNotSatisfiable
+			h.Set("Content-Range", RangeUnit+" */"+strconv.FormatInt(total, 10))
+		} else {
+			status = http.StatusPartialContent
+			last := first + int64(len(p.Items)) - 1
+			h.Set("Content-Range", RangeUnit+" "+strconv.FormatInt(first, 10)+"-"+strconv.FormatInt(last, 10)+"/"+strconv.FormatInt(total, 10))
+		}
+	}
+	w.WriteHeader(status)
+	return status
+}
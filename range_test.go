@@ -0,0 +1,87 @@
+package pageable
+
+import (
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestPageRequestWithRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		page   int
+		size   int
+		offset int
+	}{
+		{name: "no header", header: "", page: 1, size: 10, offset: 0},
+		{name: "first page", header: "items=0-24", page: 1, size: 25, offset: 0},
+		{name: "aligned", header: "items=50-74", page: 3, size: 25, offset: 50},
+		{name: "unaligned", header: "items=10-29", page: 1, size: 20, offset: 10},
+		{name: "open ended", header: "items=30-", page: 4, size: 10, offset: 30},
+		{name: "clamped", header: "items=0-5000", page: 1, size: MaxSize, offset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := PageRequestFromQuery(url.Values{"sort": {"id"}}).WithRange(tt.header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if req.Page != tt.page || req.Size != tt.size || req.Offset() != tt.offset {
+				t.Errorf("page %d, size %d, offset %d; want %d, %d, %d",
+					req.Page, req.Size, req.Offset(), tt.page, tt.size, tt.offset)
+			}
+			if len(req.Sort) != 1 {
+				t.Errorf("Sort = %v, want kept", req.Sort)
+			}
+		})
+	}
+}
+
+func TestPageRequestWithRangeInvalid(t *testing.T) {
+	for _, header := range []string{"bytes=0-9", "items=9-0", "items=-10", "items=0-9,20-29", "items=a-b", "items"} {
+		if _, err := NewPageRequest(1, 10, nil).WithRange(header); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("WithRange(%q) error = %v, want ErrInvalidRange", header, err)
+		}
+	}
+}
+
+func TestPageWriteRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		header       string
+		items        int
+		total        int64
+		status       int
+		contentRange string
+	}{
+		{name: "no range", header: "", items: 10, total: 95, status: 200},
+		{name: "partial", header: "items=20-39", items: 20, total: 95, status: 206, contentRange: "items 20-39/95"},
+		{name: "unaligned tail", header: "items=85-104", items: 10, total: 95, status: 206, contentRange: "items 85-94/95"},
+		{name: "past the end", header: "items=100-109", items: 0, total: 95, status: 416, contentRange: "items */95"},
+		{name: "empty collection", header: "items=0-9", items: 0, total: 0, status: 416, contentRange: "items */0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := NewPageRequest(1, 10, nil).WithRange(tt.header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			page := NewPage(make([]testItem, tt.items), req, tt.total)
+
+			rec := httptest.NewRecorder()
+			if got := page.WriteRange(rec); got != tt.status || rec.Code != tt.status {
+				t.Errorf("status = %d (recorded %d), want %d", got, rec.Code, tt.status)
+			}
+			if got := rec.Header().Get("Accept-Ranges"); got != "items" {
+				t.Errorf("Accept-Ranges = %q", got)
+			}
+			if got := rec.Header().Get("Content-Range"); got != tt.contentRange {
+				t.Errorf("Content-Range = %q, want %q", got, tt.contentRange)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package pageable
+
+import (
+	"errors"
+	"net/url"
+)
+
+// ScoreField is the sort alias for search relevance. Sorts on it order by the
+// expression set with SearchRequest.WithScoreExpression.
+const ScoreField = "_score"
+
+// searchTermKey is the CursorData.Extra key holding the search term a cursor was issued for.
+const searchTermKey = "q"
+
+// ErrSearchTermChanged is returned by SearchRequest.Keyset when the cursor
+// was issued for a different search term, so its scores no longer apply.
+var ErrSearchTermChanged = errors.New("pageable: cursor was issued for a different search term")
+
+// SearchRequest is a CursorRequest for full-text search endpoints, carrying
+// the search term from the "q" parameter. Relevance is sorted with the
+// ScoreField alias; since scores are not unique, add a tie-breaker so
+// cursors hold (score, id) and pages never overlap.
+//
+// Builder methods are redefined to return a SearchRequest, so chains keep the term.
+type SearchRequest struct {
+	CursorRequest
+	Term string
+}
+
+// NewSearchRequest creates a SearchRequest with defaults applied.
+// Size is clamped to [1, MaxCursorSize].
+func NewSearchRequest(term, cursor string, size int, sort []Sort) SearchRequest {
+	return SearchRequest{CursorRequest: NewCursorRequest(cursor, size, sort), Term: term}
+}
+
+// SearchRequestFromQuery parses a SearchRequest from URL query parameters.
+// Recognized keys: "q", "cursor", "size", "sort", "fields"; all other keys are parsed as
+// filters (see ParseFilters), applied once allowed by FilterableFields. With a search term and no sort, results are
+// sorted by relevance ("_score,desc"). Without a term, "_score" sorts are
+// removed, since there is nothing to rank by.
+func SearchRequestFromQuery(values url.Values, opts ...QueryOption) SearchRequest {
+	sr := SearchRequest{CursorRequest: CursorRequestFromQuery(values, opts...), Term: values.Get(paramSearch)}
+	if sr.Term == "" {
+		sr.Sort = removeScoreSorts(sr.Sort)
+	} else if sr.Sort == nil {
+		sr.Sort = []Sort{{Field: ScoreField, Direction: DESC}}
+	}
+	return sr
+}
+
+// HasTerm returns true if a non-empty search term was provided.
+func (sr SearchRequest) HasTerm() bool {
+	return sr.Term != ""
+}
+
+// WithScoreExpression sets the trusted SQL expression that "_score" sorts
+// order by, e.g. SortExpression{SQL: "ts_rank(search, plainto_tsquery(?))",
+// Args: []any{req.Term}}. Select the same expression AS _score, so
+// CursorFromItem finds it under the `db:"_score"` tag.
+func (sr SearchRequest) WithScoreExpression(expr SortExpression) SearchRequest {
+	sr.Sort = sr.mapSortExpressions(sr.Sort, SortExpressions{ScoreField: expr})
+	return sr
+}
+
+// SortableFields filters sorts to only include the specified fields.
+// Any sort with a field not in the allowed list is removed. The "_score"
+// alias is always allowed.
+func (sr SearchRequest) SortableFields(fields ...string) SearchRequest {
+	sr.Sort = filterSortsByFields(sr.Sort, append(fields[:len(fields):len(fields)], ScoreField)...)
+	return sr
+}
+
+// ApplySortPolicy works like PageRequest.ApplySortPolicy.
+func (sr SearchRequest) ApplySortPolicy(policy SortPolicy) (SearchRequest, error) {
+	cr, err := sr.CursorRequest.ApplySortPolicy(policy)
+	if err != nil {
+		return sr, err
+	}
+	sr.CursorRequest = cr
+	return sr, nil
+}
+
+// MapSortFields works like PageRequest.MapSortFields.
+func (sr SearchRequest) MapSortFields(fieldMap map[string]string) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.MapSortFields(fieldMap)
+	return sr
+}
+
+// MapJSONSortFields works like PageRequest.MapJSONSortFields.
+func (sr SearchRequest) MapJSONSortFields(fields JSONSortFields) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.MapJSONSortFields(fields)
+	return sr
+}
+
+// WithDefaultSort works like PageRequest.WithDefaultSort. The default relevance
+// sort set for a search term counts as a sort.
+func (sr SearchRequest) WithDefaultSort(sorts ...Sort) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.WithDefaultSort(sorts...)
+	return sr
+}
+
+// WithTieBreaker works like PageRequest.WithTieBreaker.
+func (sr SearchRequest) WithTieBreaker(tie Sort) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.WithTieBreaker(tie)
+	return sr
+}
+
+// WithDialect works like PageRequest.WithDialect.
+func (sr SearchRequest) WithDialect(d Dialect) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.WithDialect(d)
+	return sr
+}
+
+// MapSortExpressions works like PageRequest.MapSortExpressions.
+func (sr SearchRequest) MapSortExpressions(exprs SortExpressions) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.MapSortExpressions(exprs)
+	return sr
+}
+
+// FilterableFields works like PageRequest.FilterableFields.
+func (sr SearchRequest) FilterableFields(fields ...string) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.FilterableFields(fields...)
+	return sr
+}
+
+// SelectableFields works like PageRequest.SelectableFields.
+func (sr SearchRequest) SelectableFields(fields ...string) SearchRequest {
+	sr.CursorRequest = sr.CursorRequest.SelectableFields(fields...)
+	return sr
+}
+
+// ItemCursor encodes a cursor in direction dir from item's sort values, e.g.
+// (score, id), bound to the request's search term. Build Next cursors from
+// the last item of a page and Prev cursors from the first.
+func (sr SearchRequest) ItemCursor(item any, dir CursorDirection) (string, error) {
+	data, err := CursorFromItem(item, sr.Sort)
+	if err != nil {
+		return "", err
+	}
+	data.Direction = dir
+	if sr.Term != "" {
+		data.Extra = map[string]string{searchTermKey: sr.Term}
+	}
+	return EncodeCursor(data)
+}
+
+// Keyset decodes the cursor and returns the WHERE condition, ORDER BY clause
+// and bind arguments for the next query, as CursorRequest.Keyset does.
+// Returns ErrSearchTermChanged if the cursor was minted by ItemCursor for
+// a different term.
+func (sr SearchRequest) Keyset() (Keyset, error) {
+	data, err := sr.DecodedCursor()
+	if err != nil {
+		return Keyset{}, err
+	}
+	if term, ok := data.Extra[searchTermKey]; ok && term != sr.Term {
+		return Keyset{}, ErrSearchTermChanged
+	}
+	return newKeyset(sr.Sort, data, sr.dialect)
+}
+
+// CursorLink returns query parameters for a link to the page at cursor.
+// The search term, sorts, filters and size are carried over, in the format
+// they were parsed with, so the link reproduces the same result set.
+func (sr SearchRequest) CursorLink(cursor string) url.Values {
+	values := sr.CursorRequest.CursorLink(cursor)
+	if sr.Term != "" {
+		values.Set(paramSearch, sr.Term)
+	}
+	return values
+}
+
+// removeScoreSorts returns sorts without any "_score" sort.
+// Returns nil if no sorts remain.
+func removeScoreSorts(sorts []Sort) []Sort {
+	var kept []Sort
+	for _, s := range sorts {
+		if s.Field != ScoreField {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
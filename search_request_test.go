@@ -0,0 +1,117 @@
+package pageable
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+type searchTestRow struct {
+	ID    int64   `db:"id"`
+	Title string  `db:"title"`
+	Score float64 `db:"_score"`
+}
+
+func TestSearchRequestFromQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   url.Values
+		term     string
+		expected []Sort
+	}{
+		{
+			name:     "term defaults to relevance",
+			values:   url.Values{"q": {"go"}},
+			term:     "go",
+			expected: []Sort{{Field: ScoreField, Direction: DESC}},
+		},
+		{
+			name:     "explicit sort kept",
+			values:   url.Values{"q": {"go"}, "sort": {"title,asc"}},
+			term:     "go",
+			expected: []Sort{{Field: "title", Direction: ASC}},
+		},
+		{
+			name:     "score sort dropped without term",
+			values:   url.Values{"sort": {"_score,desc", "title,asc"}},
+			expected: []Sort{{Field: "title", Direction: ASC}},
+		},
+		{
+			name:     "no term and no sort",
+			values:   url.Values{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := SearchRequestFromQuery(tt.values)
+			if req.Term != tt.term {
+				t.Errorf("Term = %q, want %q", req.Term, tt.term)
+			}
+			if len(req.Sort) != len(tt.expected) {
+				t.Fatalf("Sort = %v, want %v", req.Sort, tt.expected)
+			}
+			for i, s := range req.Sort {
+				if s != tt.expected[i] {
+					t.Errorf("sort[%d] = %v, want %v", i, s, tt.expected[i])
+				}
+			}
+			if len(req.Filters) != 0 {
+				t.Errorf("Filters = %v, want none", req.Filters)
+			}
+		})
+	}
+}
+
+func TestSearchRequestSortableFields(t *testing.T) {
+	req := SearchRequestFromQuery(url.Values{"q": {"go"}, "sort": {"_score,desc", "secret"}}).
+		SortableFields("title")
+	if len(req.Sort) != 1 || req.Sort[0].Field != ScoreField {
+		t.Errorf("Sort = %v, want [_score desc]", req.Sort)
+	}
+	if req.Term != "go" {
+		t.Errorf("Term = %q, want %q", req.Term, "go")
+	}
+}
+
+func TestSearchRequestKeyset(t *testing.T) {
+	req := SearchRequestFromQuery(url.Values{"q": {"go"}}).
+		WithScoreExpression(SortExpression{SQL: "ts_rank(doc, plainto_tsquery(?))", Args: []any{"go"}}).
+		WithTieBreaker(Sort{Field: "id", Direction: ASC})
+
+	cursor, err := req.ItemCursor(searchTestRow{ID: 7, Score: 0.5}, Next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Cursor = cursor
+
+	ks, err := req.Keyset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantWhere := "(((ts_rank(doc, plainto_tsquery(?))) < ?) OR ((ts_rank(doc, plainto_tsquery(?))) = ? AND id > ?))"
+	if ks.Where != wantWhere {
+		t.Errorf("Where = %q, want %q", ks.Where, wantWhere)
+	}
+	if got, want := ks.OrderBy, "(ts_rank(doc, plainto_tsquery(?))) desc, id asc"; got != want {
+		t.Errorf("OrderBy = %q, want %q", got, want)
+	}
+	if len(ks.Args) != 6 || ks.Args[1] != 0.5 || ks.Args[4] != int64(7) {
+		t.Errorf("Args = %v", ks.Args)
+	}
+
+	req.Term = "rust"
+	if _, err := req.Keyset(); !errors.Is(err, ErrSearchTermChanged) {
+		t.Errorf("Keyset() error = %v, want ErrSearchTermChanged", err)
+	}
+}
+
+func TestSearchRequestCursorLink(t *testing.T) {
+	req := SearchRequestFromQuery(url.Values{"q": {"go lang"}, "lang": {"en"}}).FilterableFields("lang")
+	got := req.CursorLink("abc").Encode()
+	want := "cursor=abc&lang=en&q=go+lang&size=10&sort=_score%2Cdesc"
+	if got != want {
+		t.Errorf("CursorLink = %q, want %q", got, want)
+	}
+}
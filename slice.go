@@ -0,0 +1,268 @@
+package pageable
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortSlice sorts items in place by sorts, matching fields like CursorFromItem.
+// The sort is stable and follows the same semantics as the SQL rendering:
+// CaseInsensitive compares lowercased strings and explicit null orderings are
+// honored. Without one, nulls sort before other values in ascending order and
+// after them in descending order. Collation is ignored, since collations are
+// defined by the database.
+func SortSlice[T any](items []T, sorts []Sort) error {
+	sorted, _, err := sortItems(items, sorts)
+	if err != nil {
+		return err
+	}
+	copy(items, sorted)
+	return nil
+}
+
+// PaginateSlice returns one CursorPage of items for req, paginating in memory
+// with the same ordering and keyset semantics as CursorRequest.Keyset.
+// items is not modified. The cursor must carry Keys for every sort field;
+// the returned next and prev cursors are built with CursorFromItem, and the
+// head cursor with HeadCursor. A Head cursor returns the items before it,
+// nearest first (see Head), with HasNewer reporting whether more remain.
+func PaginateSlice[T any](items []T, req CursorRequest) (CursorPage[T], error) {
+	data, err := req.DecodedCursor()
+	if err != nil {
+		return CursorPage[T]{}, err
+	}
+	sorted, keys, err := sortItems(items, req.Sort)
+	if err != nil {
+		return CursorPage[T]{}, err
+	}
+
+	start, end := 0, min(req.Size, len(sorted))
+	if req.HasCursor() {
+		pos, err := searchCursor(keys, req.Sort, data)
+		if err != nil {
+			return CursorPage[T]{}, err
+		}
+		switch data.Direction {
+		case Prev:
+			start, end = max(0, pos-req.Size), pos
+		case Head:
+			return sliceHeadPage(sorted, pos, req)
+		default:
+			start, end = pos, min(len(sorted), pos+req.Size)
+		}
+	}
+
+	window := sorted[start:end]
+	hasPrev, hasNext := start > 0, end < len(sorted)
+	var nextCursor, prevCursor string
+	if len(window) > 0 && hasNext {
+		if nextCursor, err = sliceCursor(window[len(window)-1], req.Sort, Next); err != nil {
+			return CursorPage[T]{}, err
+		}
+	}
+	if len(window) > 0 && hasPrev {
+		if prevCursor, err = sliceCursor(window[0], req.Sort, Prev); err != nil {
+			return CursorPage[T]{}, err
+		}
+	}
+	head, err := HeadCursor(window, req)
+	if err != nil {
+		return CursorPage[T]{}, err
+	}
+	return NewCursorPage(window, nextCursor, prevCursor, hasNext, hasPrev, req.Size).WithHeadCursor(head), nil
+}
+
+// sliceHeadPage returns the page for a Head cursor: up to req.Size of the
+// items before pos in sorted, nearest first.
+func sliceHeadPage[T any](sorted []T, pos int, req CursorRequest) (CursorPage[T], error) {
+	start := max(0, pos-req.Size)
+	window := sorted[start:pos]
+	for i, j := 0, len(window)-1; i < j; i, j = i+1, j-1 {
+		window[i], window[j] = window[j], window[i]
+	}
+	head, err := HeadCursor(window, req)
+	if err != nil {
+		return CursorPage[T]{}, err
+	}
+	page := NewCursorPage(window, "", "", false, false, req.Size).WithHeadCursor(head)
+	page.Metadata.HasNewer = start > 0
+	return page, nil
+}
+
+// sortItems returns a sorted copy of items together with each item's sort values.
+func sortItems[T any](items []T, sorts []Sort) ([]T, [][]any, error) {
+	keys := make([][]any, len(items))
+	for i := range items {
+		values, err := itemValues(items[i], sorts)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = values
+	}
+
+	idx := make([]int, len(items))
+	for i := range idx {
+		idx[i] = i
+	}
+	var cmpErr error
+	sort.SliceStable(idx, func(a, b int) bool {
+		c, err := compareTuples(keys[idx[a]], keys[idx[b]], sorts)
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return c < 0
+	})
+	if cmpErr != nil {
+		return nil, nil, cmpErr
+	}
+
+	sorted := make([]T, len(items))
+	sortedKeys := make([][]any, len(items))
+	for i, j := range idx {
+		sorted[i] = items[j]
+		sortedKeys[i] = keys[j]
+	}
+	return sorted, sortedKeys, nil
+}
+
+// searchCursor returns the index of the first sorted item after the cursor,
+// or, for Prev and Head cursors, the index of the first item not before it.
+func searchCursor(keys [][]any, sorts []Sort, data CursorData) (int, error) {
+	if len(data.Keys) == 0 {
+		return 0, errors.New("pageable: cursor has no keys")
+	}
+	cursor := make([]any, len(sorts))
+	for i, s := range sorts {
+		v, ok := data.Keys.Get(s.Field)
+		if !ok {
+			return 0, fmt.Errorf("pageable: cursor has no key for sort field %q", s.Field)
+		}
+		cursor[i] = v
+	}
+
+	var cmpErr error
+	pos := sort.Search(len(keys), func(i int) bool {
+		c, err := compareTuples(keys[i], cursor, sorts)
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		if data.Direction == Prev || data.Direction == Head {
+			return c >= 0
+		}
+		return c > 0
+	})
+	return pos, cmpErr
+}
+
+// sliceCursor encodes a cursor in direction dir from item's sort values.
+func sliceCursor(item any, sorts []Sort, dir CursorDirection) (string, error) {
+	data, err := CursorFromItem(item, sorts)
+	if err != nil {
+		return "", err
+	}
+	data.Direction = dir
+	return EncodeCursor(data)
+}
+
+// compareTuples compares two rows' sort values in the order given by sorts.
+func compareTuples(a, b []any, sorts []Sort) (int, error) {
+	for i, s := range sorts {
+		c, err := compareSortValues(a[i], b[i], s)
+		if c != 0 || err != nil {
+			return c, err
+		}
+	}
+	return 0, nil
+}
+
+// compareSortValues compares two values of sort s, applying its direction,
+// null ordering and case folding.
+func compareSortValues(a, b any, s Sort) (int, error) {
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return 0, nil
+		}
+		nullsFirst := s.Nulls == NullsFirst || (s.Nulls == NullsDefault && s.Direction != DESC)
+		if (a == nil) == nullsFirst {
+			return -1, nil
+		}
+		return 1, nil
+	}
+
+	c, err := compareValues(a, b, s.CaseInsensitive)
+	if s.Direction == DESC {
+		c = -c
+	}
+	return c, err
+}
+
+// compareValues compares two non-null values of the same kind.
+// Strings are lowercased first when fold is true.
+func compareValues(a, b any, fold bool) (int, error) {
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			if fold {
+				x, y = strings.ToLower(x), strings.ToLower(y)
+			}
+			return strings.Compare(x, y), nil
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			switch {
+			case x == y:
+				return 0, nil
+			case x:
+				return 1, nil
+			}
+			return -1, nil
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y), nil
+		}
+	default:
+		if c, ok := compareNumbers(a, b); ok {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("pageable: cannot compare %T with %T", a, b)
+}
+
+// compareNumbers compares two numbers of any Go numeric type, exactly for
+// integers and as float64 otherwise.
+func compareNumbers(a, b any) (int, bool) {
+	if x, ok := toInt64(a); ok {
+		if y, ok := toInt64(b); ok {
+			return cmp.Compare(x, y), true
+		}
+	}
+	x, okA := toFloat64(a)
+	y, okB := toFloat64(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	return cmp.Compare(x, y), true
+}
+
+// toFloat64 converts any Go numeric type to float64.
+func toFloat64(v any) (float64, bool) {
+	switch f := v.(type) {
+	case float64:
+		return f, true
+	case float32:
+		return float64(f), true
+	case uint64:
+		return float64(f), true
+	case uint:
+		return float64(f), true
+	}
+	if i, ok := toInt64(v); ok {
+		return float64(i), true
+	}
+	return 0, false
+}
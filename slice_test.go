@@ -0,0 +1,203 @@
+package pageable
+
+import (
+	"testing"
+)
+
+type sliceTestItem struct {
+	ID   int      `json:"id"`
+	Name string   `json:"name"`
+	Rank *int     `json:"rank"`
+	Tags []string `json:"tags"`
+}
+
+func sliceTestItems() []sliceTestItem {
+	one, two := 1, 2
+	return []sliceTestItem{
+		{ID: 1, Name: "bob"},
+		{ID: 2, Name: "Alice", Rank: &two},
+		{ID: 3, Name: "carol", Rank: &one},
+		{ID: 4, Name: "alice"},
+		{ID: 5, Name: "Bob", Rank: &one},
+	}
+}
+
+func itemIDs(items []sliceTestItem) []int {
+	ids := make([]int, len(items))
+	for i, it := range items {
+		ids[i] = it.ID
+	}
+	return ids
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSortSlice(t *testing.T) {
+	tests := []struct {
+		name  string
+		sorts []Sort
+		want  []int
+	}{
+		{
+			name:  "case sensitive",
+			sorts: []Sort{{Field: "name", Direction: ASC}, {Field: "id", Direction: ASC}},
+			want:  []int{2, 5, 4, 1, 3},
+		},
+		{
+			name:  "case insensitive",
+			sorts: []Sort{{Field: "name", Direction: ASC, CaseInsensitive: true}, {Field: "id", Direction: ASC}},
+			want:  []int{2, 4, 1, 5, 3},
+		},
+		{
+			name:  "default nulls first ascending",
+			sorts: []Sort{{Field: "rank", Direction: ASC}, {Field: "id", Direction: ASC}},
+			want:  []int{1, 4, 3, 5, 2},
+		},
+		{
+			name:  "nulls last descending id",
+			sorts: []Sort{{Field: "rank", Direction: ASC, Nulls: NullsLast}, {Field: "id", Direction: DESC}},
+			want:  []int{5, 3, 2, 4, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := sliceTestItems()
+			if err := SortSlice(items, tt.sorts); err != nil {
+				t.Fatalf("SortSlice error: %v", err)
+			}
+			if got := itemIDs(items); !equalInts(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortSliceErrors(t *testing.T) {
+	items := sliceTestItems()
+	if err := SortSlice(items, []Sort{{Field: "missing", Direction: ASC}}); err == nil {
+		t.Error("expected error for unknown field")
+	}
+	if err := SortSlice(items, []Sort{{Field: "tags", Direction: ASC}}); err == nil {
+		t.Error("expected error for unsupported field type")
+	}
+}
+
+func TestPaginateSliceTraversal(t *testing.T) {
+	items := sliceTestItems()
+	sorts := []Sort{{Field: "name", Direction: ASC, CaseInsensitive: true}, {Field: "id", Direction: ASC}}
+	want := []int{2, 4, 1, 5, 3}
+
+	// Forward through every page.
+	var got []int
+	req := CursorRequest{Size: 2, Sort: sorts}
+	var last CursorPage[sliceTestItem]
+	for {
+		page, err := PaginateSlice(items, req)
+		if err != nil {
+			t.Fatalf("PaginateSlice error: %v", err)
+		}
+		got = append(got, itemIDs(page.Items)...)
+		last = page
+		if !page.Metadata.HasNext {
+			break
+		}
+		req.Cursor = page.Metadata.NextCursor
+	}
+	if !equalInts(got, want) {
+		t.Errorf("forward = %v, want %v", got, want)
+	}
+
+	// Backward from the last page.
+	if !last.Metadata.HasPrev {
+		t.Fatal("last page should have a previous page")
+	}
+	req.Cursor = last.Metadata.PrevCursor
+	page, err := PaginateSlice(items, req)
+	if err != nil {
+		t.Fatalf("PaginateSlice error: %v", err)
+	}
+	if ids := itemIDs(page.Items); !equalInts(ids, []int{1, 5}) {
+		t.Errorf("prev page = %v, want [1 5]", ids)
+	}
+	if !page.Metadata.HasNext || !page.Metadata.HasPrev {
+		t.Errorf("metadata = %+v, want hasNext and hasPrev", page.Metadata)
+	}
+}
+
+func TestPaginateSliceCursorWithoutKeys(t *testing.T) {
+	cursor, _ := EncodeCursor(CursorData{Value: "1"})
+	req := CursorRequest{Cursor: cursor, Size: 2, Sort: []Sort{{Field: "id", Direction: ASC}}}
+	if _, err := PaginateSlice(sliceTestItems(), req); err == nil {
+		t.Error("expected error for cursor without keys")
+	}
+}
+
+func TestPaginateSliceHead(t *testing.T) {
+	items := sliceTestItems()
+	// Newest first: the feed's top item has the highest id.
+	sorts := []Sort{{Field: "id", Direction: DESC}}
+
+	req := CursorRequest{Size: 2, Sort: sorts}
+	first, err := PaginateSlice(items[:2], req)
+	if err != nil {
+		t.Fatalf("PaginateSlice error: %v", err)
+	}
+	if first.Metadata.HasPrev || first.Metadata.HeadCursor == "" {
+		t.Fatalf("metadata = %+v, want a head cursor without hasPrev", first.Metadata)
+	}
+
+	// Items 3, 4 and 5 arrive; refresh from the head in chronological order.
+	tests := []struct {
+		name     string
+		items    []sliceTestItem
+		want     []int
+		hasNewer bool
+	}{
+		{name: "newer items, oldest first", items: items, want: []int{3, 4}, hasNewer: true},
+		{name: "nothing newer", items: items[:2], want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := CursorRequest{Cursor: first.Metadata.HeadCursor, Size: 2, Sort: sorts}
+			page, err := PaginateSlice(tt.items, req)
+			if err != nil {
+				t.Fatalf("PaginateSlice error: %v", err)
+			}
+			if ids := itemIDs(page.Items); !equalInts(ids, tt.want) {
+				t.Errorf("items = %v, want %v", ids, tt.want)
+			}
+			if page.Metadata.HasNext || page.Metadata.NextCursor != "" {
+				t.Errorf("hasNext = %v, nextCursor = %q, want no next page", page.Metadata.HasNext, page.Metadata.NextCursor)
+			}
+			if page.Metadata.HasNewer != tt.hasNewer {
+				t.Errorf("hasNewer = %v, want %v", page.Metadata.HasNewer, tt.hasNewer)
+			}
+			if len(tt.want) == 0 && page.Metadata.HeadCursor != req.Cursor {
+				t.Error("empty refresh should keep the head cursor")
+			}
+		})
+	}
+
+	// The fresh head cursor continues from the newest item returned.
+	req.Cursor = first.Metadata.HeadCursor
+	page, _ := PaginateSlice(items, req)
+	req.Cursor = page.Metadata.HeadCursor
+	page, err = PaginateSlice(items, req)
+	if err != nil {
+		t.Fatalf("PaginateSlice error: %v", err)
+	}
+	if ids := itemIDs(page.Items); !equalInts(ids, []int{5}) || page.Metadata.HasNewer {
+		t.Errorf("second refresh = %v (hasNewer %v), want [5] and no more", ids, page.Metadata.HasNewer)
+	}
+}
@@ -14,59 +14,117 @@ const (
 	DESC Direction = "desc"
 )
 
+// reverse returns the opposite direction.
+func (d Direction) reverse() Direction {
+	if d == DESC {
+		return ASC
+	}
+	return DESC
+}
+
+// NullOrder controls where null values are placed in a sort.
+type NullOrder string
+
+const (
+	// NullsDefault leaves null placement to the database.
+	NullsDefault NullOrder = ""
+	// NullsFirst places nulls before all other values.
+	NullsFirst NullOrder = "nullsfirst"
+	// NullsLast places nulls after all other values.
+	NullsLast NullOrder = "nullslast"
+)
+
+// reverse returns the opposite null placement.
+func (n NullOrder) reverse() NullOrder {
+	switch n {
+	case NullsFirst:
+		return NullsLast
+	case NullsLast:
+		return NullsFirst
+	}
+	return NullsDefault
+}
+
+// caseInsensitiveOption is the sort option that enables case-insensitive ordering.
+const caseInsensitiveOption = "ci"
+
 // Sort represents a single sort field with its direction.
 type Sort struct {
 	Field     string
 	Direction Direction
+	// Nulls places null values first or last. The zero value keeps the database default.
+	Nulls NullOrder
+	// CaseInsensitive orders text ignoring case, rendered as LOWER(field)
+	// (COLLATE NOCASE on SQLite).
+	CaseInsensitive bool
+	// Collation orders text using the named collation (e.g., "und-x-icu").
+	// It is never parsed from query parameters; set it server-side.
+	Collation string
+	// Expr, when set, is ordered by instead of Field, which remains the public
+	// alias and cursor key name. Set it with MapSortExpressions.
+	Expr *SortExpression
+	// JSONPath, when set, orders by a value inside a JSON column instead of Field,
+	// which remains the public alias and cursor key name. Set it with MapJSONSortFields.
+	JSONPath *JSONPath
 }
 
-// String returns the sort as "field,direction" (e.g., "name,desc" or "id,asc").
+// String returns the sort as "field,direction" (e.g., "name,desc" or "id,asc"),
+// followed by the null ordering and "ci" when set (e.g., "due_date,desc,nullslast").
 func (s Sort) String() string {
-	return s.Field + "," + string(s.Direction)
+	return s.Format(SortSyntaxDefault)
 }
 
 // ParseSort parses a "field,direction" sort string into a Sort.
-// Format: "field,direction" where direction is "asc" or "desc".
-// If direction is omitted, defaults to ascending.
-// Examples: "id,desc" -> {id, desc}, "name,asc" -> {name, asc}, "name" -> {name, asc}.
+// Format: "field,direction[,nulls][,ci]" where direction is "asc" or "desc",
+// nulls is "nullsfirst" or "nullslast", and "ci" sorts case-insensitively.
+// If direction is omitted, defaults to ascending. Unknown options are ignored.
+// Examples: "id,desc" -> {id, desc}, "name,asc" -> {name, asc}, "name" -> {name, asc},
+// "due_date,desc,nullslast" -> {due_date, desc, nullslast}, "name,asc,ci" -> {name, asc, case-insensitive}.
 // Returns nil for empty input.
 func ParseSort(raw string) *Sort {
-	raw = strings.TrimSpace(raw)
-	if raw == "" {
-		return nil
-	}
+	parts := strings.Split(raw, ",")
+	return newSort(parts[0], parts[1:])
+}
+
+// ParseSorts parses multiple "field,direction" sort strings as typically received
+// from url.Values where ?sort=id,desc&sort=name,asc yields []string{"id,desc", "name,asc"}.
+// Each string is parsed with ParseSort. Use SortSyntax.Parse for other formats.
+func ParseSorts(raw []string) []Sort {
+	return SortSyntaxDefault.Parse(raw)
+}
 
-	parts := strings.SplitN(raw, ",", 2)
-	field := strings.TrimSpace(parts[0])
+// newSort builds a Sort from a field name and option tokens such as "desc",
+// "nullslast" or "ci". Returns nil if the field is empty or unsafe.
+func newSort(field string, opts []string) *Sort {
+	field = strings.TrimSpace(field)
 	if field == "" || !isSafeIdentifier(field) {
 		return nil
 	}
 
-	dir := ASC
-	if len(parts) == 2 {
-		d := strings.TrimSpace(strings.ToLower(parts[1]))
-		if Direction(d) == DESC {
-			dir = DESC
+	s := Sort{Field: field, Direction: ASC}
+	for _, opt := range opts {
+		switch o := strings.TrimSpace(strings.ToLower(opt)); o {
+		case string(DESC):
+			s.Direction = DESC
+		case string(NullsFirst), string(NullsLast):
+			s.Nulls = NullOrder(o)
+		case caseInsensitiveOption:
+			s.CaseInsensitive = true
 		}
 	}
-
-	return &Sort{Field: field, Direction: dir}
+	return &s
 }
 
-// ParseSorts parses multiple "field,direction" sort strings as typically received
-// from url.Values where ?sort=id,desc&sort=name,asc yields []string{"id,desc", "name,asc"}.
-// Each string is parsed with ParseSort.
-func ParseSorts(raw []string) []Sort {
-	var sorts []Sort
-	for _, r := range raw {
-		if s := ParseSort(r); s != nil {
-			sorts = append(sorts, *s)
-		}
+// options returns the non-default option tokens of s in canonical order.
+func (s Sort) options() []string {
+	var opts []string
+	if s.Nulls != NullsDefault {
+		opts = append(opts, string(s.Nulls))
 	}
-	if len(sorts) == 0 {
-		return nil
+	if s.CaseInsensitive {
+		opts = append(opts, caseInsensitiveOption)
 	}
-	return sorts
+	return opts
 }
 
 // filterSortsByFields returns only sorts whose field is in the allowed list.
@@ -89,7 +147,8 @@ func filterSortsByFields(sorts []Sort, fields ...string) []Sort {
 }
 
 // mapSortFields replaces sort field names using the provided mapping.
-// If a field has a mapping, it is replaced; otherwise the sort is kept as-is.
+// If a field has a mapping, only the field is replaced and its other options
+// (null ordering, case, collation, expressions) are kept; otherwise the sort is kept as-is.
 func mapSortFields(sorts []Sort, fieldMap map[string]string) []Sort {
 	if len(sorts) == 0 || len(fieldMap) == 0 {
 		return sorts
@@ -97,14 +156,114 @@ func mapSortFields(sorts []Sort, fieldMap map[string]string) []Sort {
 	mapped := make([]Sort, len(sorts))
 	for i, s := range sorts {
 		if to, ok := fieldMap[s.Field]; ok {
-			mapped[i] = Sort{Field: to, Direction: s.Direction}
-		} else {
-			mapped[i] = s
+			s.Field = to
 		}
+		mapped[i] = s
 	}
 	return mapped
 }
 
+// appendTieBreaker returns sorts with tie appended unless a sort on the same field exists.
+// The input slice is never modified. tie should be a unique key (e.g.,
+// Sort{Field: "id", Direction: ASC}) so rows with equal sort values keep a
+// total order and are never duplicated or skipped across pages. Since the
+// result is non-nil, request builders apply it after WithDefaultSort.
+func appendTieBreaker(sorts []Sort, tie Sort) []Sort {
+	for _, s := range sorts {
+		if s.Field == tie.Field {
+			return sorts
+		}
+	}
+	out := make([]Sort, len(sorts), len(sorts)+1)
+	copy(out, sorts)
+	return append(out, tie)
+}
+
+// reverseSorts returns sorts with every direction and null ordering flipped.
+func reverseSorts(sorts []Sort) []Sort {
+	if len(sorts) == 0 {
+		return sorts
+	}
+	out := make([]Sort, len(sorts))
+	for i, s := range sorts {
+		s.Direction = s.Direction.reverse()
+		s.Nulls = s.Nulls.reverse()
+		out[i] = s
+	}
+	return out
+}
+
+// orderBy renders sorts as an ORDER BY clause like "name desc, id asc" for dialect d,
+// with the bind arguments of any sort expressions in order.
+// Null ordering is rendered as NULLS FIRST/LAST, or emulated with an IS NULL
+// sort key where the dialect lacks it.
+// Returns an empty string if there are no sorts.
+func orderBy(sorts []Sort, d Dialect) (string, []any) {
+	if len(sorts) == 0 {
+		return "", nil
+	}
+	var args []any
+	parts := make([]string, 0, len(sorts))
+	for _, s := range sorts {
+		if s.Nulls != NullsDefault && !d.supportsNullsOrdering() {
+			col, colArgs := sortColumn(s, d)
+			dir := ASC
+			if s.Nulls == NullsFirst {
+				dir = DESC
+			}
+			parts = append(parts, col+" IS NULL "+string(dir))
+			args = append(args, colArgs...)
+		}
+
+		expr, exprArgs, _ := sortExpr(s, d)
+		term := expr + " " + string(s.Direction)
+		if s.Nulls != NullsDefault && d.supportsNullsOrdering() {
+			term += nullsClause(s.Nulls)
+		}
+		parts = append(parts, term)
+		args = append(args, exprArgs...)
+	}
+	return strings.Join(parts, ", "), args
+}
+
+// sortColumn returns the column, JSON path extraction or parenthesized
+// expression s refers to in dialect d, with the expression's bind arguments.
+func sortColumn(s Sort, d Dialect) (string, []any) {
+	switch {
+	case s.Expr != nil:
+		return "(" + s.Expr.SQL + ")", s.Expr.Args
+	case s.JSONPath != nil:
+		return s.JSONPath.sql(d), nil
+	}
+	return s.Field, nil
+}
+
+// sortExpr returns the SQL expression that s orders and compares by in dialect d,
+// its bind arguments, and the placeholder for values compared against it, so
+// keyset conditions use the same case folding and collation as ORDER BY.
+func sortExpr(s Sort, d Dialect) (expr string, args []any, placeholder string) {
+	expr, args = sortColumn(s, d)
+	placeholder = "?"
+	if s.CaseInsensitive {
+		if d == SQLite && s.Collation == "" {
+			return expr + " COLLATE NOCASE", args, placeholder
+		}
+		expr, placeholder = "LOWER("+expr+")", "LOWER(?)"
+	}
+	if s.Collation != "" && isSafeCollation(s.Collation) {
+		expr += " COLLATE " + d.quoteCollation(s.Collation)
+	}
+	return expr, args, placeholder
+}
+
+// nullsClause returns the NULLS FIRST/LAST suffix for n.
+func nullsClause(n NullOrder) string {
+	if n == NullsFirst {
+		return " nulls first"
+	}
+	return " nulls last"
+}
+
 // isSafeIdentifier checks that a field name contains only safe SQL identifier
 // characters: letters, digits, underscores, and dots (for table-qualified names like "posts.id").
 func isSafeIdentifier(s string) bool {
@@ -115,3 +274,9 @@ func isSafeIdentifier(s string) bool {
 	}
 	return true
 }
+
+// isSafeCollation checks that a collation name contains only letters, digits,
+// underscores, dots and hyphens (e.g., "und-x-icu", "utf8mb4_0900_ai_ci").
+func isSafeCollation(s string) bool {
+	return isSafeIdentifier(strings.ReplaceAll(s, "-", "_"))
+}
@@ -0,0 +1,30 @@
+package pageable
+
+// SortExpression is a trusted SQL expression that a public sort alias orders by,
+// such as "likes*2 + comments" or "COALESCE(updated_at, created_at)".
+type SortExpression struct {
+	// SQL is emitted verbatim and must never contain user input.
+	// Use "?" placeholders with Args for parameters.
+	SQL string
+	// Args are bind arguments for the placeholders in SQL.
+	Args []any
+}
+
+// SortExpressions maps public sort aliases to trusted SQL expressions.
+type SortExpressions map[string]SortExpression
+
+// mapSortExpressions attaches the registered expression to each sort whose field is an alias.
+// Other sorts are kept as-is.
+func mapSortExpressions(sorts []Sort, exprs SortExpressions) []Sort {
+	if len(sorts) == 0 || len(exprs) == 0 {
+		return sorts
+	}
+	mapped := make([]Sort, len(sorts))
+	for i, s := range sorts {
+		if e, ok := exprs[s.Field]; ok {
+			s.Expr = &e
+		}
+		mapped[i] = s
+	}
+	return mapped
+}
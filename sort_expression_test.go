@@ -0,0 +1,69 @@
+package pageable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapSortExpressions(t *testing.T) {
+	exprs := SortExpressions{
+		"rank":   {SQL: "likes * ? + comments", Args: []any{2}},
+		"recent": {SQL: "COALESCE(updated_at, created_at)"},
+	}
+	req := PageRequest{Sort: []Sort{
+		{Field: "rank", Direction: DESC},
+		{Field: "recent", Direction: DESC},
+		{Field: "id", Direction: ASC},
+	}}.MapSortExpressions(exprs)
+
+	if want := "(likes * ? + comments) desc, (COALESCE(updated_at, created_at)) desc, id asc"; req.OrderBy() != want {
+		t.Errorf("OrderBy() = %q, want %q", req.OrderBy(), want)
+	}
+	if want := []any{2}; !reflect.DeepEqual(req.OrderByArgs(), want) {
+		t.Errorf("OrderByArgs() = %v, want %v", req.OrderByArgs(), want)
+	}
+	if req.Sort[0].Field != "rank" {
+		t.Errorf("Field = %q, want alias %q", req.Sort[0].Field, "rank")
+	}
+}
+
+func TestMapSortExpressionsEmpty(t *testing.T) {
+	sorts := []Sort{{Field: "id", Direction: ASC}}
+	if got := mapSortExpressions(sorts, nil); !reflect.DeepEqual(got, sorts) {
+		t.Errorf("mapSortExpressions = %v, want unchanged", got)
+	}
+	if got := mapSortExpressions(nil, SortExpressions{"a": {SQL: "1"}}); got != nil {
+		t.Errorf("mapSortExpressions(nil) = %v, want nil", got)
+	}
+}
+
+func TestKeysetSortExpression(t *testing.T) {
+	exprs := SortExpressions{"rank": {SQL: "likes * ? + comments", Args: []any{2}}}
+	req := CursorRequest{Size: 10, Sort: []Sort{
+		{Field: "rank", Direction: DESC, Nulls: NullsLast},
+		{Field: "id", Direction: ASC},
+	}}.MapSortExpressions(exprs).WithDialect(MySQL)
+
+	req.Cursor, _ = EncodeCursor(CursorData{Keys: CursorKeys{
+		{Field: "rank", Value: int64(40)},
+		{Field: "id", Value: int64(7)},
+	}})
+	ks, err := req.Keyset()
+	if err != nil {
+		t.Fatalf("Keyset error: %v", err)
+	}
+
+	wantWhere := "((((likes * ? + comments) < ? OR (likes * ? + comments) IS NULL)) OR " +
+		"((likes * ? + comments) = ? AND id > ?))"
+	if ks.Where != wantWhere {
+		t.Errorf("Where = %q, want %q", ks.Where, wantWhere)
+	}
+	wantOrder := "(likes * ? + comments) IS NULL asc, (likes * ? + comments) desc, id asc"
+	if ks.OrderBy != wantOrder {
+		t.Errorf("OrderBy = %q, want %q", ks.OrderBy, wantOrder)
+	}
+	wantArgs := []any{2, int64(40), 2, 2, int64(40), int64(7), 2, 2}
+	if !reflect.DeepEqual(ks.Args, wantArgs) {
+		t.Errorf("Args = %v, want %v", ks.Args, wantArgs)
+	}
+}
@@ -0,0 +1,116 @@
+package pageable
+
+import (
+	"strings"
+)
+
+// SortViolationReason describes why a sort was rejected by a SortPolicy.
+type SortViolationReason string
+
+const (
+	// SortFieldNotAllowed means the field is not listed in SortPolicy.Fields.
+	SortFieldNotAllowed SortViolationReason = "field not sortable"
+	// SortDirectionNotAllowed means the field may not be sorted in that direction.
+	SortDirectionNotAllowed SortViolationReason = "direction not allowed"
+	// SortDuplicateField means the field was already sorted by an earlier sort.
+	SortDuplicateField SortViolationReason = "duplicate field"
+	// SortTooManyKeys means the sort exceeds SortPolicy.MaxSorts.
+	SortTooManyKeys SortViolationReason = "too many sort keys"
+)
+
+// SortViolation is a single sort rejected by a SortPolicy.
+type SortViolation struct {
+	Sort   Sort
+	Reason SortViolationReason
+}
+
+// SortPolicyError is returned by SortPolicy.Apply in strict mode and lists every violation.
+type SortPolicyError struct {
+	Violations []SortViolation
+}
+
+// Error implements the error interface.
+func (e *SortPolicyError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Sort.String() + ": " + string(v.Reason)
+	}
+	return "pageable: invalid sort: " + strings.Join(parts, "; ")
+}
+
+// SortRule restricts how a single field may be sorted.
+type SortRule struct {
+	// Directions lists the allowed directions. Empty allows both.
+	Directions []Direction
+}
+
+// allows reports whether the rule permits sorting in dir.
+func (r SortRule) allows(dir Direction) bool {
+	if len(r.Directions) == 0 {
+		return true
+	}
+	for _, d := range r.Directions {
+		if d == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// SortPolicy enforces per-field sort rules, a maximum number of sort keys,
+// and collapses repeated fields to their first occurrence.
+type SortPolicy struct {
+	// Fields maps each sortable field to its rule. A nil map allows any field.
+	Fields map[string]SortRule
+	// MaxSorts limits the number of sort keys. Zero means no limit.
+	MaxSorts int
+	// Strict reports violations as a *SortPolicyError instead of dropping the offending sorts.
+	Strict bool
+}
+
+// Apply returns the sorts permitted by the policy, in their original order.
+// Sorts on unlisted fields or disallowed directions and repeated fields are
+// dropped, and the result is truncated to MaxSorts. In strict mode, any such
+// violation returns a *SortPolicyError instead.
+// Returns nil if no sorts remain.
+func (p SortPolicy) Apply(sorts []Sort) ([]Sort, error) {
+	var (
+		kept       []Sort
+		violations []SortViolation
+	)
+	seen := make(map[string]struct{}, len(sorts))
+	for _, s := range sorts {
+		reason := p.check(s, seen, len(kept))
+		if reason != "" {
+			violations = append(violations, SortViolation{Sort: s, Reason: reason})
+			continue
+		}
+		seen[s.Field] = struct{}{}
+		kept = append(kept, s)
+	}
+
+	if p.Strict && len(violations) > 0 {
+		return nil, &SortPolicyError{Violations: violations}
+	}
+	return kept, nil
+}
+
+// check returns the reason s violates the policy, or "" if it is allowed.
+func (p SortPolicy) check(s Sort, seen map[string]struct{}, kept int) SortViolationReason {
+	if p.Fields != nil {
+		rule, ok := p.Fields[s.Field]
+		if !ok {
+			return SortFieldNotAllowed
+		}
+		if !rule.allows(s.Direction) {
+			return SortDirectionNotAllowed
+		}
+	}
+	if _, dup := seen[s.Field]; dup {
+		return SortDuplicateField
+	}
+	if p.MaxSorts > 0 && kept >= p.MaxSorts {
+		return SortTooManyKeys
+	}
+	return ""
+}
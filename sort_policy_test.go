@@ -0,0 +1,127 @@
+package pageable
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSortPolicyApply(t *testing.T) {
+	policy := SortPolicy{
+		Fields: map[string]SortRule{
+			"id":    {},
+			"name":  {},
+			"score": {Directions: []Direction{DESC}},
+		},
+		MaxSorts: 2,
+	}
+
+	tests := []struct {
+		name     string
+		sorts    []Sort
+		expected []Sort
+	}{
+		{
+			name:     "allowed sorts kept",
+			sorts:    []Sort{{Field: "score", Direction: DESC}, {Field: "id", Direction: ASC}},
+			expected: []Sort{{Field: "score", Direction: DESC}, {Field: "id", Direction: ASC}},
+		},
+		{
+			name:     "disallowed direction dropped",
+			sorts:    []Sort{{Field: "score", Direction: ASC}, {Field: "id", Direction: ASC}},
+			expected: []Sort{{Field: "id", Direction: ASC}},
+		},
+		{
+			name:     "unknown field dropped",
+			sorts:    []Sort{{Field: "secret", Direction: ASC}},
+			expected: nil,
+		},
+		{
+			name:     "duplicates collapse to first",
+			sorts:    []Sort{{Field: "id", Direction: ASC}, {Field: "id", Direction: DESC}, {Field: "name", Direction: DESC}},
+			expected: []Sort{{Field: "id", Direction: ASC}, {Field: "name", Direction: DESC}},
+		},
+		{
+			name: "truncated to max sorts",
+			sorts: []Sort{
+				{Field: "name", Direction: ASC},
+				{Field: "score", Direction: DESC},
+				{Field: "id", Direction: ASC},
+			},
+			expected: []Sort{{Field: "name", Direction: ASC}, {Field: "score", Direction: DESC}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.Apply(tt.sorts)
+			if err != nil {
+				t.Fatalf("Apply error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Apply = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSortPolicyStrict(t *testing.T) {
+	policy := SortPolicy{
+		Fields:   map[string]SortRule{"id": {}, "score": {Directions: []Direction{DESC}}},
+		MaxSorts: 1,
+		Strict:   true,
+	}
+	sorts := []Sort{
+		{Field: "id", Direction: ASC},
+		{Field: "id", Direction: DESC},
+		{Field: "score", Direction: ASC},
+		{Field: "secret", Direction: ASC},
+		{Field: "score", Direction: DESC},
+	}
+
+	_, err := policy.Apply(sorts)
+	var policyErr *SortPolicyError
+	if !errors.As(err, &policyErr) {
+		t.Fatalf("error = %v, want *SortPolicyError", err)
+	}
+	want := []SortViolationReason{SortDuplicateField, SortDirectionNotAllowed, SortFieldNotAllowed, SortTooManyKeys}
+	if len(policyErr.Violations) != len(want) {
+		t.Fatalf("Violations = %v, want %d", policyErr.Violations, len(want))
+	}
+	for i, v := range policyErr.Violations {
+		if v.Reason != want[i] {
+			t.Errorf("Violations[%d].Reason = %q, want %q", i, v.Reason, want[i])
+		}
+	}
+	if !strings.Contains(err.Error(), "score,asc: direction not allowed") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+
+	if got, err := policy.Apply([]Sort{{Field: "id", Direction: ASC}}); err != nil || len(got) != 1 {
+		t.Errorf("Apply valid = %v, %v", got, err)
+	}
+}
+
+func TestSortPolicyNilFields(t *testing.T) {
+	policy := SortPolicy{MaxSorts: 1}
+	got, err := policy.Apply([]Sort{{Field: "any", Direction: ASC}, {Field: "other", Direction: ASC}})
+	if err != nil {
+		t.Fatalf("Apply error: %v", err)
+	}
+	if len(got) != 1 || got[0].Field != "any" {
+		t.Errorf("Apply = %v, want [any asc]", got)
+	}
+}
+
+func TestRequestApplySortPolicy(t *testing.T) {
+	policy := SortPolicy{Fields: map[string]SortRule{"id": {}}, Strict: true}
+
+	pr, err := PageRequest{Sort: []Sort{{Field: "id", Direction: DESC}}}.ApplySortPolicy(policy)
+	if err != nil || len(pr.Sort) != 1 {
+		t.Errorf("PageRequest.ApplySortPolicy = %v, %v", pr.Sort, err)
+	}
+	if _, err := (CursorRequest{Sort: []Sort{{Field: "name", Direction: ASC}}}).ApplySortPolicy(policy); err == nil {
+		t.Error("expected strict policy error")
+	}
+}
@@ -0,0 +1,127 @@
+package pageable
+
+import (
+	"strings"
+)
+
+// SortSyntax selects how sorts are written in query parameters.
+type SortSyntax int
+
+const (
+	// SortSyntaxDefault is one "field,direction" per parameter: ?sort=name,desc&sort=id,asc.
+	SortSyntaxDefault SortSyntax = iota
+	// SortSyntaxPrefix is a comma list where "-" marks descending and "+" is optional:
+	// ?sort=-created_at,name (JSON:API, Django). Null ordering and "ci" cannot be expressed.
+	SortSyntaxPrefix
+	// SortSyntaxColon is a comma list of "field:direction" with optional ":nullslast" and ":ci":
+	// ?sort=name:desc,id.
+	SortSyntaxColon
+	// SortSyntaxSQL is a comma list of "field direction" like an ORDER BY clause:
+	// ?order_by=name desc nulls last, id asc.
+	SortSyntaxSQL
+)
+
+// Parse parses sort parameter values written in the syntax.
+// Each value may hold one sort (SortSyntaxDefault) or a comma list (other syntaxes).
+// Invalid entries are skipped. Returns nil if no valid sorts are found.
+func (ss SortSyntax) Parse(raw []string) []Sort {
+	var sorts []Sort
+	for _, r := range raw {
+		if ss == SortSyntaxDefault {
+			if s := ParseSort(r); s != nil {
+				sorts = append(sorts, *s)
+			}
+			continue
+		}
+		for _, item := range strings.Split(r, ",") {
+			if s := ss.parseItem(item); s != nil {
+				sorts = append(sorts, *s)
+			}
+		}
+	}
+	if len(sorts) == 0 {
+		return nil
+	}
+	return sorts
+}
+
+// parseItem parses a single list item in a list syntax.
+func (ss SortSyntax) parseItem(item string) *Sort {
+	item = strings.TrimSpace(item)
+	switch ss {
+	case SortSyntaxPrefix:
+		if rest, ok := strings.CutPrefix(item, "-"); ok {
+			return newSort(rest, []string{string(DESC)})
+		}
+		return newSort(strings.TrimPrefix(item, "+"), nil)
+	case SortSyntaxColon:
+		parts := strings.Split(item, ":")
+		return newSort(parts[0], parts[1:])
+	case SortSyntaxSQL:
+		parts := strings.Fields(item)
+		if len(parts) == 0 {
+			return nil
+		}
+		return newSort(parts[0], joinNullsTokens(parts[1:]))
+	}
+	return nil
+}
+
+// joinNullsTokens merges SQL-style "nulls last" token pairs into "nullslast".
+func joinNullsTokens(tokens []string) []string {
+	out := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		if strings.EqualFold(tokens[i], "nulls") && i+1 < len(tokens) {
+			out = append(out, "nulls"+tokens[i+1])
+			i++
+			continue
+		}
+		out = append(out, tokens[i])
+	}
+	return out
+}
+
+// Format returns query parameter values for sorts in the syntax, the inverse of Parse.
+// SortSyntaxDefault yields one value per sort; list syntaxes yield a single value.
+// Returns nil if there are no sorts.
+func (ss SortSyntax) Format(sorts []Sort) []string {
+	if len(sorts) == 0 {
+		return nil
+	}
+	items := make([]string, len(sorts))
+	for i, s := range sorts {
+		items[i] = s.Format(ss)
+	}
+	if ss == SortSyntaxDefault {
+		return items
+	}
+	sep := ","
+	if ss == SortSyntaxSQL {
+		sep = ", "
+	}
+	return []string{strings.Join(items, sep)}
+}
+
+// Format returns the sort written in the given syntax:
+// "name,desc" (default), "-name" (prefix), "name:desc" (colon) or "name desc" (SQL).
+func (s Sort) Format(syntax SortSyntax) string {
+	switch syntax {
+	case SortSyntaxPrefix:
+		if s.Direction == DESC {
+			return "-" + s.Field
+		}
+		return s.Field
+	case SortSyntaxColon:
+		return strings.Join(append([]string{s.Field, string(s.Direction)}, s.options()...), ":")
+	case SortSyntaxSQL:
+		str := s.Field + " " + string(s.Direction)
+		if s.Nulls != NullsDefault {
+			str += nullsClause(s.Nulls)
+		}
+		if s.CaseInsensitive {
+			str += " " + caseInsensitiveOption
+		}
+		return str
+	}
+	return strings.Join(append([]string{s.Field, string(s.Direction)}, s.options()...), ",")
+}
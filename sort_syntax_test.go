@@ -0,0 +1,136 @@
+package pageable
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSortSyntaxParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		syntax   SortSyntax
+		input    []string
+		expected []Sort
+	}{
+		{
+			name:   "default",
+			syntax: SortSyntaxDefault,
+			input:  []string{"name,desc", "id"},
+			expected: []Sort{
+				{Field: "name", Direction: DESC},
+				{Field: "id", Direction: ASC},
+			},
+		},
+		{
+			name:   "prefix",
+			syntax: SortSyntaxPrefix,
+			input:  []string{"-created_at, +name,id"},
+			expected: []Sort{
+				{Field: "created_at", Direction: DESC},
+				{Field: "name", Direction: ASC},
+				{Field: "id", Direction: ASC},
+			},
+		},
+		{
+			name:   "prefix repeated parameters",
+			syntax: SortSyntaxPrefix,
+			input:  []string{"-created_at", "name"},
+			expected: []Sort{
+				{Field: "created_at", Direction: DESC},
+				{Field: "name", Direction: ASC},
+			},
+		},
+		{
+			name:   "colon",
+			syntax: SortSyntaxColon,
+			input:  []string{"name:desc:ci,due:asc:nullslast,id"},
+			expected: []Sort{
+				{Field: "name", Direction: DESC, CaseInsensitive: true},
+				{Field: "due", Direction: ASC, Nulls: NullsLast},
+				{Field: "id", Direction: ASC},
+			},
+		},
+		{
+			name:   "sql",
+			syntax: SortSyntaxSQL,
+			input:  []string{"name DESC, due asc NULLS LAST, id"},
+			expected: []Sort{
+				{Field: "name", Direction: DESC},
+				{Field: "due", Direction: ASC, Nulls: NullsLast},
+				{Field: "id", Direction: ASC},
+			},
+		},
+		{
+			name:     "unsafe fields skipped",
+			syntax:   SortSyntaxPrefix,
+			input:    []string{"-id;drop,, -"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.syntax.Parse(tt.input)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSortSyntaxRoundTrip(t *testing.T) {
+	sorts := []Sort{
+		{Field: "name", Direction: DESC, CaseInsensitive: true},
+		{Field: "due", Direction: ASC, Nulls: NullsLast},
+		{Field: "id", Direction: ASC},
+	}
+	tests := []struct {
+		syntax   SortSyntax
+		expected []string
+	}{
+		{SortSyntaxDefault, []string{"name,desc,ci", "due,asc,nullslast", "id,asc"}},
+		{SortSyntaxColon, []string{"name:desc:ci,due:asc:nullslast,id:asc"}},
+		{SortSyntaxSQL, []string{"name desc ci, due asc nulls last, id asc"}},
+	}
+
+	for _, tt := range tests {
+		formatted := tt.syntax.Format(sorts)
+		if !reflect.DeepEqual(formatted, tt.expected) {
+			t.Errorf("Format(%d) = %q, want %q", tt.syntax, formatted, tt.expected)
+		}
+		if got := tt.syntax.Parse(formatted); !reflect.DeepEqual(got, sorts) {
+			t.Errorf("Parse(Format(%d)) = %v, want %v", tt.syntax, got, sorts)
+		}
+	}
+
+	// The prefix syntax keeps only fields and directions.
+	if got := SortSyntaxPrefix.Format(sorts); !reflect.DeepEqual(got, []string{"-name,due,id"}) {
+		t.Errorf("prefix Format = %q", got)
+	}
+	if got := SortSyntaxPrefix.Format(nil); got != nil {
+		t.Errorf("Format(nil) = %q, want nil", got)
+	}
+}
+
+func TestFromQueryWithSortOptions(t *testing.T) {
+	values := url.Values{"order_by": {"name desc, id asc"}, "sort": {"ignored,asc"}}
+	opts := []QueryOption{WithSortParam("order_by"), WithSortSyntax(SortSyntaxSQL)}
+	want := []Sort{{Field: "name", Direction: DESC}, {Field: "id", Direction: ASC}}
+
+	if got := PageRequestFromQuery(values, opts...).Sort; !reflect.DeepEqual(got, want) {
+		t.Errorf("PageRequestFromQuery Sort = %v, want %v", got, want)
+	}
+	if got := CursorRequestFromQuery(values, opts...).Sort; !reflect.DeepEqual(got, want) {
+		t.Errorf("CursorRequestFromQuery Sort = %v, want %v", got, want)
+	}
+
+	hr := HybridRequestFromQuery(values, opts...)
+	link := hr.PageLink(2, "")
+	if got := link.Get("order_by"); got != "name desc, id asc" {
+		t.Errorf("PageLink order_by = %q, want %q", got, "name desc, id asc")
+	}
+	if link.Has("sort") {
+		t.Error("PageLink should not contain the default sort parameter")
+	}
+}
@@ -80,6 +80,21 @@ func TestParseSort(t *testing.T) {
 			input:    "created_at,asc",
 			expected: &Sort{Field: "created_at", Direction: ASC},
 		},
+		{
+			name:     "nulls last",
+			input:    "due_date,desc,nullslast",
+			expected: &Sort{Field: "due_date", Direction: DESC, Nulls: NullsLast},
+		},
+		{
+			name:     "nulls first without direction",
+			input:    "due_date,NullsFirst",
+			expected: &Sort{Field: "due_date", Direction: ASC, Nulls: NullsFirst},
+		},
+		{
+			name:     "case insensitive option",
+			input:    "name,asc,ci",
+			expected: &Sort{Field: "name", Direction: ASC, CaseInsensitive: true},
+		},
 		{
 			name:     "comma only",
 			input:    ",",
@@ -110,6 +125,12 @@ func TestParseSort(t *testing.T) {
 			if result.Direction != tt.expected.Direction {
 				t.Errorf("Direction = %q, want %q", result.Direction, tt.expected.Direction)
 			}
+			if result.Nulls != tt.expected.Nulls {
+				t.Errorf("Nulls = %q, want %q", result.Nulls, tt.expected.Nulls)
+			}
+			if result.CaseInsensitive != tt.expected.CaseInsensitive {
+				t.Errorf("CaseInsensitive = %v, want %v", result.CaseInsensitive, tt.expected.CaseInsensitive)
+			}
 		})
 	}
 }
@@ -195,6 +216,8 @@ func TestSortString(t *testing.T) {
 		{Sort{Field: "name", Direction: ASC}, "name,asc"},
 		{Sort{Field: "name", Direction: DESC}, "name,desc"},
 		{Sort{Field: "id", Direction: ASC}, "id,asc"},
+		{Sort{Field: "due", Direction: DESC, Nulls: NullsLast}, "due,desc,nullslast"},
+		{Sort{Field: "name", Direction: ASC, CaseInsensitive: true}, "name,asc,ci"},
 	}
 
 	for _, tt := range tests {
@@ -228,3 +251,25 @@ func TestSortStringRoundTrip(t *testing.T) {
 		}
 	}
 }
+
+func TestAppendTieBreaker(t *testing.T) {
+	id := Sort{Field: "id", Direction: ASC}
+	sorts := []Sort{{Field: "name", Direction: DESC}}
+
+	got := appendTieBreaker(sorts, id)
+	if len(got) != 2 || got[1] != id {
+		t.Errorf("appendTieBreaker = %v, want [name desc, id asc]", got)
+	}
+	if len(sorts) != 1 {
+		t.Errorf("input modified: %v", sorts)
+	}
+
+	withID := []Sort{{Field: "id", Direction: DESC}, {Field: "name", Direction: ASC}}
+	if got := appendTieBreaker(withID, id); len(got) != 2 || got[0].Direction != DESC {
+		t.Errorf("appendTieBreaker = %v, want unchanged", got)
+	}
+
+	if got := appendTieBreaker(nil, id); len(got) != 1 || got[0] != id {
+		t.Errorf("appendTieBreaker(nil) = %v, want [id asc]", got)
+	}
+}
@@ -0,0 +1,314 @@
+package pageable
+
+import (
+	"bufio"
+	"context"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StreamFormat is the encoding written by StreamPages and StreamCursorPages.
+type StreamFormat int
+
+const (
+	// NDJSON writes one JSON object per line.
+	NDJSON StreamFormat = iota
+	// CSV writes a header row followed by one row per item. Columns come from
+	// the item struct's `csv` tags, then `json` tags, then field names; "-" skips a field.
+	CSV
+)
+
+// StreamOptions configures StreamPages and StreamCursorPages.
+type StreamOptions struct {
+	Format StreamFormat
+	// Trailer writes a final StreamSummary record after the last item:
+	// {"metadata":{...}} for NDJSON, or a "#metadata,items=N,pages=N" row for CSV.
+	Trailer bool
+}
+
+// StreamSummary counts what a stream wrote.
+type StreamSummary struct {
+	Items int64 `json:"items"`
+	Pages int   `json:"pages"`
+}
+
+// PageFetchFunc fetches the offset page described by req.
+type PageFetchFunc[T any] func(ctx context.Context, req PageRequest) (Page[T], error)
+
+// CursorFetchFunc fetches the cursor page described by req.
+type CursorFetchFunc[T any] func(ctx context.Context, req CursorRequest) (CursorPage[T], error)
+
+// StreamPages writes every page from req onward to w, fetching one page at a
+// time so memory stays bounded by the page size. Output is buffered and
+// flushed after each page, including w's own Flush (e.g., http.Flusher).
+// It stops after the last page, an empty page, or when ctx is done.
+func StreamPages[T any](
+	ctx context.Context, w io.Writer, req PageRequest, fetch PageFetchFunc[T], opts StreamOptions,
+) (StreamSummary, error) {
+	s, err := newStreamer[T](w, opts)
+	if err != nil {
+		return StreamSummary{}, err
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return s.summary, err
+		}
+		page, err := fetch(ctx, req)
+		if err != nil {
+			return s.summary, err
+		}
+		if err := s.writePage(page.Items); err != nil {
+			return s.summary, err
+		}
+		if len(page.Items) == 0 || page.Metadata.Page >= page.Metadata.lastPage() {
+			return s.finish()
+		}
+		req.Page = page.Metadata.Page + 1
+	}
+}
+
+// StreamCursorPages writes every page from req onward to w like StreamPages,
+// following NextCursor until a page has no next page.
+func StreamCursorPages[T any](
+	ctx context.Context, w io.Writer, req CursorRequest, fetch CursorFetchFunc[T], opts StreamOptions,
+) (StreamSummary, error) {
+	s, err := newStreamer[T](w, opts)
+	if err != nil {
+		return StreamSummary{}, err
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return s.summary, err
+		}
+		page, err := fetch(ctx, req)
+		if err != nil {
+			return s.summary, err
+		}
+		if err := s.writePage(page.Items); err != nil {
+			return s.summary, err
+		}
+		if !page.Metadata.HasNext || page.Metadata.NextCursor == "" {
+			return s.finish()
+		}
+		req.Cursor = page.Metadata.NextCursor
+	}
+}
+
+// streamer encodes items of type T to a buffered writer.
+type streamer[T any] struct {
+	dst     io.Writer
+	buf     *bufio.Writer
+	opts    StreamOptions
+	json    *json.Encoder
+	csv     *csv.Writer
+	columns []csvColumn
+	summary StreamSummary
+}
+
+// newStreamer prepares a streamer, writing the CSV header row if needed.
+func newStreamer[T any](w io.Writer, opts StreamOptions) (*streamer[T], error) {
+	s := &streamer[T]{dst: w, buf: bufio.NewWriter(w), opts: opts}
+	switch opts.Format {
+	case NDJSON:
+		s.json = json.NewEncoder(s.buf)
+	case CSV:
+		columns, err := csvColumns(reflect.TypeOf((*T)(nil)).Elem())
+		if err != nil {
+			return nil, err
+		}
+		s.columns = columns
+		s.csv = csv.NewWriter(s.buf)
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = c.name
+		}
+		if err := s.csv.Write(header); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("pageable: unknown stream format %d", opts.Format)
+	}
+	return s, nil
+}
+
+// writePage writes the items of one page and flushes.
+func (s *streamer[T]) writePage(items []T) error {
+	for _, item := range items {
+		if err := s.writeItem(item); err != nil {
+			return err
+		}
+		s.summary.Items++
+	}
+	s.summary.Pages++
+	return s.flush()
+}
+
+// writeItem encodes one item.
+func (s *streamer[T]) writeItem(item T) error {
+	if s.json != nil {
+		return s.json.Encode(item)
+	}
+	row, err := csvRow(reflect.ValueOf(&item).Elem(), s.columns)
+	if err != nil {
+		return err
+	}
+	return s.csv.Write(row)
+}
+
+// finish writes the trailer, if enabled, and flushes.
+func (s *streamer[T]) finish() (StreamSummary, error) {
+	if s.opts.Trailer {
+		var err error
+		if s.json != nil {
+			err = s.json.Encode(struct {
+				Metadata StreamSummary `json:"metadata"`
+			}{s.summary})
+		} else {
+			err = s.csv.Write([]string{
+				"#metadata",
+				"items=" + strconv.FormatInt(s.summary.Items, 10),
+				"pages=" + strconv.Itoa(s.summary.Pages),
+			})
+		}
+		if err != nil {
+			return s.summary, err
+		}
+	}
+	return s.summary, s.flush()
+}
+
+// flush pushes buffered output to the destination and flushes it too when it
+// supports flushing.
+func (s *streamer[T]) flush() error {
+	if s.csv != nil {
+		s.csv.Flush()
+		if err := s.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if err := s.buf.Flush(); err != nil {
+		return err
+	}
+	switch f := s.dst.(type) {
+	case interface{ Flush() error }:
+		return f.Flush()
+	case interface{ Flush() }:
+		f.Flush()
+	}
+	return nil
+}
+
+// csvColumn is a CSV column and the struct field it reads.
+type csvColumn struct {
+	name  string
+	index []int
+}
+
+// csvColumns returns the columns of struct type t (or a pointer to one),
+// including fields of embedded structs.
+func csvColumns(t reflect.Type) ([]csvColumn, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("pageable: CSV items must be structs, got %s", t)
+	}
+
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name := csvName(sf)
+		if name == "-" {
+			continue
+		}
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct && name == "" {
+			embedded, err := csvColumns(sf.Type)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range embedded {
+				columns = append(columns, csvColumn{name: c.name, index: append([]int{i}, c.index...)})
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		columns = append(columns, csvColumn{name: name, index: []int{i}})
+	}
+	return columns, nil
+}
+
+// csvName returns the column name set by the field's csv or json tag.
+func csvName(sf reflect.StructField) string {
+	for _, tag := range []string{"csv", "json"} {
+		if name, _, _ := strings.Cut(sf.Tag.Get(tag), ","); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// csvRow formats the columns of struct value v.
+func csvRow(v reflect.Value, columns []csvColumn) ([]string, error) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, errors.New("pageable: CSV item is nil")
+		}
+		v = v.Elem()
+	}
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		s, err := csvValue(v.FieldByIndex(c.index))
+		if err != nil {
+			return nil, fmt.Errorf("pageable: CSV column %q: %w", c.name, err)
+		}
+		row[i] = s
+	}
+	return row, nil
+}
+
+// csvValue formats a field value. Nil pointers are empty, times use RFC 3339,
+// and other values that are not scalars are encoded as JSON.
+func csvValue(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.Format(time.RFC3339Nano), nil
+	case encoding.TextMarshaler:
+		b, err := x.MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	b, err := json.Marshal(v.Interface())
+	return string(b), err
+}
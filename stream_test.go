@@ -0,0 +1,123 @@
+package pageable
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type streamTestRow struct {
+	ID      int        `csv:"id"`
+	Name    string     `json:"name"`
+	Secret  string     `csv:"-"`
+	Created time.Time  `csv:"created_at"`
+	Deleted *time.Time `csv:"deleted_at"`
+	Score   float64
+}
+
+// flushRecorder records the buffer length at each Flush.
+type flushRecorder struct {
+	bytes.Buffer
+	flushes []int
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushes = append(f.flushes, f.Len())
+}
+
+func streamTestPages(rows []streamTestRow, size int) PageFetchFunc[streamTestRow] {
+	return func(_ context.Context, req PageRequest) (Page[streamTestRow], error) {
+		req.Size = size
+		start := min(req.Offset(), len(rows))
+		end := min(start+size, len(rows))
+		return NewPage(rows[start:end], req, int64(len(rows))), nil
+	}
+}
+
+func TestStreamPagesNDJSON(t *testing.T) {
+	rows := []streamTestRow{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 3, Name: "c"}}
+	var out flushRecorder
+	summary, err := StreamPages(context.Background(), &out, PageRequest{Page: 1, Size: 2}, streamTestPages(rows, 2), StreamOptions{Trailer: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary != (StreamSummary{Items: 3, Pages: 2}) {
+		t.Errorf("summary = %+v", summary)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("lines = %q", lines)
+	}
+	if !strings.HasPrefix(lines[0], `{"ID":1,"name":"a"`) {
+		t.Errorf("line 0 = %s", lines[0])
+	}
+	if lines[3] != `{"metadata":{"items":3,"pages":2}}` {
+		t.Errorf("trailer = %s", lines[3])
+	}
+	if len(out.flushes) != 3 || out.flushes[0] != len(lines[0])+len(lines[1])+2 {
+		t.Errorf("flushes = %v, want one per page plus the trailer", out.flushes)
+	}
+}
+
+func TestStreamCursorPagesCSV(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	pages := map[string]CursorPage[streamTestRow]{
+		"":   NewCursorPage([]streamTestRow{{ID: 1, Name: "a,b", Secret: "x", Created: created, Score: 1.5}}, "c1", "", true, false, 1),
+		"c1": NewCursorPage([]streamTestRow{{ID: 2, Name: "c", Deleted: &created}}, "", "c1", false, true, 1),
+	}
+	fetch := func(_ context.Context, req CursorRequest) (CursorPage[streamTestRow], error) {
+		return pages[req.Cursor], nil
+	}
+
+	var out bytes.Buffer
+	summary, err := StreamCursorPages(context.Background(), &out, CursorRequest{Size: 1}, fetch, StreamOptions{Format: CSV, Trailer: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Items != 2 {
+		t.Errorf("summary = %+v", summary)
+	}
+	want := "id,name,created_at,deleted_at,Score\n" +
+		"1,\"a,b\",2024-01-02T03:04:05Z,,1.5\n" +
+		"2,c,0001-01-01T00:00:00Z,2024-01-02T03:04:05Z,0\n" +
+		"#metadata,items=2,pages=2\n"
+	if out.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rows := make([]streamTestRow, 10)
+	fetch := streamTestPages(rows, 2)
+	calls := 0
+	wrapped := func(ctx context.Context, req PageRequest) (Page[streamTestRow], error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return fetch(ctx, req)
+	}
+
+	var out bytes.Buffer
+	summary, err := StreamPages(ctx, &out, PageRequest{Page: 1, Size: 2}, wrapped, StreamOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if summary.Pages != 2 {
+		t.Errorf("summary = %+v, want 2 pages", summary)
+	}
+}
+
+func TestStreamCSVRequiresStruct(t *testing.T) {
+	fetch := func(context.Context, CursorRequest) (CursorPage[int], error) {
+		return CursorPage[int]{}, nil
+	}
+	if _, err := StreamCursorPages(context.Background(), &bytes.Buffer{}, CursorRequest{}, fetch, StreamOptions{Format: CSV}); err == nil {
+		t.Error("expected error for non-struct items")
+	}
+}
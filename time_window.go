@@ -0,0 +1,269 @@
+package pageable
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTimeWindow is returned by TimeWindowRequestFromQuery for
+// malformed or reversed since/until bounds.
+var ErrInvalidTimeWindow = errors.New("pageable: invalid time window")
+
+// Default columns ordering a time-window feed.
+const (
+	defaultTimeField = "created_at"
+	defaultIDField   = "id"
+)
+
+// TimeWindowRequest represents time-window pagination for event feeds and
+// audit logs: items with a timestamp in [Since, Until), oldest first, paged
+// with a cursor over (timestamp, id) so items with equal timestamps are never
+// skipped or repeated. A zero Since or Until leaves that side unbounded.
+type TimeWindowRequest struct {
+	Since   time.Time
+	Until   time.Time
+	Cursor  string
+	Size    int
+	Filters []Filter
+
+	timeField string
+	idField   string
+	requestState
+}
+
+// TimeWindowRequestFromQuery parses a TimeWindowRequest from URL query parameters.
+// Recognized keys: "since", "until" (RFC 3339), "cursor", "limit"; all other
+// keys are parsed as filters (see ParseFilters), applied once allowed by FilterableFields. Limit defaults to
+// DefaultCursorSize and is clamped to [1, MaxCursorSize].
+// With maxWindow > 0, the window is limited to maxWindow: a missing Since is
+// set to Until (or now) minus maxWindow, a missing Until to Since plus
+// maxWindow, and a longer window is shortened by moving Until.
+// Returns an error wrapping ErrInvalidTimeWindow if a bound is not RFC 3339
+// or Until is not after Since.
+func TimeWindowRequestFromQuery(values url.Values, maxWindow time.Duration, opts ...QueryOption) (TimeWindowRequest, error) {
+	query := newQueryConfig(opts)
+
+	since, err := parseWindowBound(values, paramSince)
+	if err != nil {
+		return TimeWindowRequest{}, err
+	}
+	until, err := parseWindowBound(values, paramUntil)
+	if err != nil {
+		return TimeWindowRequest{}, err
+	}
+	if !since.IsZero() && !until.IsZero() && !until.After(since) {
+		return TimeWindowRequest{}, fmt.Errorf("%w: until %s is not after since %s",
+			ErrInvalidTimeWindow, until.Format(time.RFC3339Nano), since.Format(time.RFC3339Nano))
+	}
+
+	if maxWindow > 0 {
+		switch {
+		case since.IsZero() && until.IsZero():
+			since = time.Now().Add(-maxWindow)
+		case since.IsZero():
+			since = until.Add(-maxWindow)
+		case until.IsZero() || until.Sub(since) > maxWindow:
+			until = since.Add(maxWindow)
+		}
+	}
+
+	size := DefaultCursorSize
+	if v := values.Get(paramLimit); v != "" {
+		if l, err := strconv.Atoi(v); err == nil && l > 0 {
+			size = l
+		}
+	}
+	if size > MaxCursorSize {
+		size = MaxCursorSize
+	}
+
+	return TimeWindowRequest{
+		Since:        since,
+		Until:        until,
+		Cursor:       values.Get(paramCursor),
+		Size:         size,
+		requestState: newRequestState(values, query),
+	}, nil
+}
+
+// parseWindowBound parses the RFC 3339 time in values[key].
+// Returns the zero time if the key is missing.
+func parseWindowBound(values url.Values, key string) (time.Time, error) {
+	v := values.Get(key)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s %q is not an RFC 3339 time", ErrInvalidTimeWindow, key, v)
+	}
+	return t, nil
+}
+
+// WithTimeFields sets the timestamp and unique id columns that order the feed.
+// The defaults are "created_at" and "id". Unsafe names are ignored.
+func (tr TimeWindowRequest) WithTimeFields(timeField, idField string) TimeWindowRequest {
+	if timeField != "" && isSafeIdentifier(timeField) {
+		tr.timeField = timeField
+	}
+	if idField != "" && isSafeIdentifier(idField) {
+		tr.idField = idField
+	}
+	return tr
+}
+
+// WithDialect sets the SQL dialect used by Keyset. The default is ANSI.
+func (tr TimeWindowRequest) WithDialect(d Dialect) TimeWindowRequest {
+	tr.dialect = d
+	return tr
+}
+
+// FilterableFields works like PageRequest.FilterableFields.
+func (tr TimeWindowRequest) FilterableFields(fields ...string) TimeWindowRequest {
+	tr.Filters = tr.allowQueryFilters(tr.Filters, fields)
+	return tr
+}
+
+// Where works like PageRequest.Where; AND it with Keyset().Where.
+func (tr TimeWindowRequest) Where() string {
+	where, _ := filtersWhere(tr.Filters)
+	return where
+}
+
+// WhereArgs works like PageRequest.WhereArgs.
+func (tr TimeWindowRequest) WhereArgs() []any {
+	_, args := filtersWhere(tr.Filters)
+	return args
+}
+
+// Sort returns the feed order: the timestamp column, then the id column, ascending.
+func (tr TimeWindowRequest) Sort() []Sort {
+	timeField, idField := tr.timeField, tr.idField
+	if timeField == "" {
+		timeField = defaultTimeField
+	}
+	if idField == "" {
+		idField = defaultIDField
+	}
+	return []Sort{{Field: timeField, Direction: ASC}, {Field: idField, Direction: ASC}}
+}
+
+// Limit returns Size + 1 for database queries.
+// Querying one extra item is the standard way to detect whether more items exist
+// without a separate COUNT query.
+func (tr TimeWindowRequest) Limit() int {
+	return tr.Size + 1
+}
+
+// HasCursor returns true if a non-empty cursor was provided.
+func (tr TimeWindowRequest) HasCursor() bool {
+	return tr.Cursor != ""
+}
+
+// DecodedCursor decodes and returns the full CursorData.
+// Returns (CursorData{}, nil) if no cursor is set.
+func (tr TimeWindowRequest) DecodedCursor() (CursorData, error) {
+	if tr.Cursor == "" {
+		return CursorData{}, nil
+	}
+	return DecodeCursor(tr.Cursor)
+}
+
+// Keyset returns the WHERE condition selecting the window's items after the
+// cursor, the ORDER BY clause and the bind arguments, e.g.
+// "created_at >= ? AND created_at < ? AND ((created_at > ?) OR (created_at = ? AND id > ?))".
+func (tr TimeWindowRequest) Keyset() (Keyset, error) {
+	data, err := tr.DecodedCursor()
+	if err != nil {
+		return Keyset{}, err
+	}
+	sorts := tr.Sort()
+	ks, err := newKeyset(sorts, data, tr.dialect)
+	if err != nil {
+		return Keyset{}, err
+	}
+
+	var (
+		conds []string
+		args  []any
+	)
+	if !tr.Since.IsZero() {
+		conds = append(conds, sorts[0].Field+" >= ?")
+		args = append(args, tr.Since)
+	}
+	if !tr.Until.IsZero() {
+		conds = append(conds, sorts[0].Field+" < ?")
+		args = append(args, tr.Until)
+	}
+	if ks.Where != "" {
+		conds = append(conds, ks.Where)
+	}
+	ks.Where = strings.Join(conds, " AND ")
+	ks.Args = append(args, ks.Args...)
+	return ks, nil
+}
+
+// WindowLink returns query parameters for a link to the page at cursor within
+// the same window. Limit and filters are carried over.
+func (tr TimeWindowRequest) WindowLink(cursor string) url.Values {
+	values := url.Values{}
+	if !tr.Since.IsZero() {
+		values.Set(paramSince, tr.Since.Format(time.RFC3339Nano))
+	}
+	if !tr.Until.IsZero() {
+		values.Set(paramUntil, tr.Until.Format(time.RFC3339Nano))
+	}
+	if cursor != "" {
+		values.Set(paramCursor, cursor)
+	}
+	values.Set(paramLimit, strconv.Itoa(tr.Size))
+	setFilters(values, tr.Filters)
+	return values
+}
+
+// NewTimeWindowPage creates a CursorPage from up to req.Limit() items fetched
+// with req.Keyset, trimming the extra item. NextCursor is always set, even on
+// the last page, as the watermark to poll with for newer items, and NextSince
+// holds the newest item's timestamp. On an empty page both carry over from
+// the request. Items must have a time.Time field for the timestamp column
+// (matched like CursorFromItem).
+func NewTimeWindowPage[T any](items []T, req TimeWindowRequest) (CursorPage[T], error) {
+	hasNext := len(items) > req.Size
+	if hasNext {
+		items = items[:req.Size]
+	}
+	sorts := req.Sort()
+
+	next := req.Cursor
+	data, err := req.DecodedCursor()
+	if err != nil {
+		return CursorPage[T]{}, err
+	}
+	if len(items) > 0 {
+		if data, err = CursorFromItem(items[len(items)-1], sorts); err != nil {
+			return CursorPage[T]{}, err
+		}
+		if next, err = EncodeCursor(data); err != nil {
+			return CursorPage[T]{}, err
+		}
+	}
+
+	var nextSince string
+	if len(data.Keys) > 0 {
+		ts, err := data.Keys.Time(sorts[0].Field)
+		if err != nil {
+			return CursorPage[T]{}, err
+		}
+		nextSince = ts.Format(time.RFC3339Nano)
+	} else if !req.Since.IsZero() {
+		nextSince = req.Since.Format(time.RFC3339Nano)
+	}
+
+	page := NewCursorPage(items, next, "", hasNext, false, req.Size)
+	page.Metadata.NextSince = nextSince
+	return page, nil
+}
@@ -0,0 +1,219 @@
+package pageable
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type eventTestRow struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func TestTimeWindowRequestFromQuery(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		values    url.Values
+		maxWindow time.Duration
+		since     time.Time
+		until     time.Time
+		size      int
+		err       error
+	}{
+		{
+			name:   "both bounds",
+			values: url.Values{"since": {"2024-01-01T00:00:00Z"}, "until": {"2024-01-02T00:00:00Z"}, "limit": {"50"}},
+			since:  t0,
+			until:  t0.Add(24 * time.Hour),
+			size:   50,
+		},
+		{
+			name:   "unbounded",
+			values: url.Values{},
+			size:   DefaultCursorSize,
+		},
+		{
+			name:      "window clamped",
+			values:    url.Values{"since": {"2024-01-01T00:00:00Z"}, "until": {"2024-02-01T00:00:00Z"}},
+			maxWindow: time.Hour,
+			since:     t0,
+			until:     t0.Add(time.Hour),
+			size:      DefaultCursorSize,
+		},
+		{
+			name:      "missing until filled",
+			values:    url.Values{"since": {"2024-01-01T00:00:00Z"}},
+			maxWindow: time.Hour,
+			since:     t0,
+			until:     t0.Add(time.Hour),
+			size:      DefaultCursorSize,
+		},
+		{
+			name:      "missing since filled",
+			values:    url.Values{"until": {"2024-01-01T00:00:00Z"}},
+			maxWindow: time.Hour,
+			since:     t0.Add(-time.Hour),
+			until:     t0,
+			size:      DefaultCursorSize,
+		},
+		{
+			name:   "limit clamped",
+			values: url.Values{"limit": {"5000"}},
+			size:   MaxCursorSize,
+		},
+		{
+			name:   "invalid since",
+			values: url.Values{"since": {"yesterday"}},
+			err:    ErrInvalidTimeWindow,
+		},
+		{
+			name:   "reversed window",
+			values: url.Values{"since": {"2024-01-02T00:00:00Z"}, "until": {"2024-01-01T00:00:00Z"}},
+			err:    ErrInvalidTimeWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := TimeWindowRequestFromQuery(tt.values, tt.maxWindow)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if !req.Since.Equal(tt.since) || !req.Until.Equal(tt.until) {
+				t.Errorf("window = [%v, %v), want [%v, %v)", req.Since, req.Until, tt.since, tt.until)
+			}
+			if req.Size != tt.size {
+				t.Errorf("Size = %d, want %d", req.Size, tt.size)
+			}
+		})
+	}
+}
+
+func TestTimeWindowRequestFromQueryDefaultWindow(t *testing.T) {
+	before := time.Now()
+	req, err := TimeWindowRequestFromQuery(url.Values{}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without bounds, the window is the last maxWindow and stays open-ended.
+	if req.Since.Before(before.Add(-time.Hour)) || req.Since.After(time.Now().Add(-time.Hour)) {
+		t.Errorf("Since = %v, want an hour ago", req.Since)
+	}
+	if !req.Until.IsZero() {
+		t.Errorf("Until = %v, want zero", req.Until)
+	}
+}
+
+func TestTimeWindowRequestKeyset(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	req, _ := TimeWindowRequestFromQuery(url.Values{
+		"since": {"2024-01-01T00:00:00Z"},
+		"until": {"2024-01-02T00:00:00Z"},
+	}, 0)
+
+	ks, err := req.Keyset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "created_at >= ? AND created_at < ?"; ks.Where != want {
+		t.Errorf("Where = %q, want %q", ks.Where, want)
+	}
+	if want := "created_at asc, id asc"; ks.OrderBy != want {
+		t.Errorf("OrderBy = %q, want %q", ks.OrderBy, want)
+	}
+
+	req = req.WithTimeFields("events.created_at", "events.id")
+	page, err := NewTimeWindowPage([]eventTestRow{{ID: 7, CreatedAt: t0}}, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Cursor = page.Metadata.NextCursor
+	ks, err = req.Keyset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "events.created_at >= ? AND events.created_at < ? AND " +
+		"((events.created_at > ?) OR (events.created_at = ? AND events.id > ?))"
+	if ks.Where != want {
+		t.Errorf("Where = %q, want %q", ks.Where, want)
+	}
+	if len(ks.Args) != 5 || ks.Args[4] != int64(7) {
+		t.Errorf("Args = %v, want window bounds then keyset values ending in 7", ks.Args)
+	}
+}
+
+func TestNewTimeWindowPage(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []eventTestRow{
+		{ID: 1, CreatedAt: t0},
+		{ID: 2, CreatedAt: t0},
+		{ID: 3, CreatedAt: t0.Add(time.Second)},
+	}
+	req := TimeWindowRequest{Since: t0, Size: 2}
+
+	page, err := NewTimeWindowPage(rows, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || !page.Metadata.HasNext {
+		t.Fatalf("items = %d, hasNext = %v, want 2 and true", len(page.Items), page.Metadata.HasNext)
+	}
+	data, err := DecodeCursor(page.Metadata.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := data.Keys.Int64("id"); id != 2 {
+		t.Errorf("cursor id = %d, want 2", id)
+	}
+	if want := t0.Format(time.RFC3339Nano); page.Metadata.NextSince != want {
+		t.Errorf("NextSince = %q, want %q", page.Metadata.NextSince, want)
+	}
+
+	// The last page still returns a cursor to poll with.
+	req.Cursor = page.Metadata.NextCursor
+	last, err := NewTimeWindowPage(rows[2:], req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Metadata.HasNext || last.Metadata.NextCursor == "" {
+		t.Errorf("hasNext = %v, nextCursor = %q, want false and a cursor", last.Metadata.HasNext, last.Metadata.NextCursor)
+	}
+
+	// An empty poll keeps the watermark.
+	req.Cursor = last.Metadata.NextCursor
+	empty, err := NewTimeWindowPage([]eventTestRow(nil), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty.Metadata.NextCursor != req.Cursor || empty.Metadata.NextSince != last.Metadata.NextSince {
+		t.Errorf("empty page metadata = %+v, want watermark of previous page", empty.Metadata)
+	}
+	if empty.Items == nil {
+		t.Error("Items = nil, want empty slice")
+	}
+}
+
+func TestTimeWindowRequestWindowLink(t *testing.T) {
+	req, _ := TimeWindowRequestFromQuery(url.Values{
+		"since":  {"2024-01-01T00:00:00Z"},
+		"limit":  {"20"},
+		"status": {"open"},
+	}, 0)
+	got := req.FilterableFields("status").WindowLink("abc")
+	want := url.Values{
+		"since":  {"2024-01-01T00:00:00Z"},
+		"cursor": {"abc"},
+		"limit":  {"20"},
+		"status": {"open"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WindowLink = %v, want %v", got, want)
+	}
+}